@@ -1,7 +1,28 @@
 package main
 
-import "library/internal/infrastructure/config"
+import (
+	"log/slog"
+	"os"
+
+	"library/internal/infrastructure/config"
+	"library/internal/infrastructure/logging"
+)
 
 func main() {
-	config.StartApp()
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		slog.Error("Failed to load the configuration", "error", err)
+		os.Exit(1)
+	}
+	logger, err := logging.New(os.Stdout, cfg.Log)
+	if err != nil {
+		slog.Error("Failed to set up logging", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	slog.Info("Configuration loaded", "config", cfg)
+	if err := config.StartApp(cfg); err != nil {
+		slog.Error("Failed to start the application", "error", err)
+		os.Exit(1)
+	}
 }
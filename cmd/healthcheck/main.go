@@ -0,0 +1,26 @@
+// Command healthcheck probes the readiness endpoint of the API on this host
+// and exits with 1 unless it answers 200. The runtime image has no shell or
+// curl, so docker-compose runs this instead.
+package main
+
+import (
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get("http://127.0.0.1:" + port + "/health/ready")
+	if err != nil {
+		os.Exit(1)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		os.Exit(1)
+	}
+}
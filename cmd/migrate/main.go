@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"library/internal/infrastructure/config"
+	"library/internal/infrastructure/logging"
+)
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		slog.Error("Failed to load the configuration", "error", err)
+		os.Exit(1)
+	}
+	logger, err := logging.New(os.Stdout, cfg.Log)
+	if err != nil {
+		slog.Error("Failed to set up logging", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	if err := config.RunMigrate(cfg.DB, os.Args[1:]); err != nil {
+		slog.Error("Migration failed", "error", err)
+		os.Exit(1)
+	}
+}
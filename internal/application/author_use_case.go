@@ -6,14 +6,18 @@ import (
 	"github.com/google/uuid"
 
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 	"library/internal/domain/service"
 )
 
 type AuthorUseCaseInterface interface {
 	CreateAuthorUseCase(ctx context.Context, author *model.Author) error
-	GetAuthorsUseCase(ctx context.Context) ([]model.Author, error)
+	GetAuthorsUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error)
 	GetAuthorUseCase(ctx context.Context, id uuid.UUID) (*model.Author, error)
 	UpdateAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error)
+	PatchAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error)
+	DeleteAuthorUseCase(ctx context.Context, id uuid.UUID, force bool) error
+	RestoreAuthorUseCase(ctx context.Context, id uuid.UUID) (*model.Author, error)
 }
 
 type AuthorUseCase struct {
@@ -28,8 +32,8 @@ func (a *AuthorUseCase) CreateAuthorUseCase(ctx context.Context, author *model.A
 	return a.service.CreateAuthor(ctx, author)
 }
 
-func (a *AuthorUseCase) GetAuthorsUseCase(ctx context.Context) ([]model.Author, error) {
-	return a.service.GetAuthors(ctx)
+func (a *AuthorUseCase) GetAuthorsUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error) {
+	return a.service.GetAuthors(ctx, query)
 }
 
 func (a *AuthorUseCase) GetAuthorUseCase(ctx context.Context, id uuid.UUID) (*model.Author, error) {
@@ -39,3 +43,15 @@ func (a *AuthorUseCase) GetAuthorUseCase(ctx context.Context, id uuid.UUID) (*mo
 func (a *AuthorUseCase) UpdateAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error) {
 	return a.service.UpdateAuthor(ctx, id, patch)
 }
+
+func (a *AuthorUseCase) PatchAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error) {
+	return a.service.PatchAuthor(ctx, id, patch)
+}
+
+func (a *AuthorUseCase) DeleteAuthorUseCase(ctx context.Context, id uuid.UUID, force bool) error {
+	return a.service.DeleteAuthor(ctx, id, force)
+}
+
+func (a *AuthorUseCase) RestoreAuthorUseCase(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	return a.service.RestoreAuthor(ctx, id)
+}
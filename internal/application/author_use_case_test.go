@@ -10,6 +10,7 @@ import (
 
 	"library/internal/application"
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 	"library/internal/domain/service"
 	"library/internal/test/builder"
 	mmockAuthorRepo "library/internal/test/mock"
@@ -52,9 +53,7 @@ func TestGivenAuthorWhenAppUpdateInDataBaseThenReturnNilError(t *testing.T) {
 	existing := builder.NewAuthorBuilder().Build()
 	updated := *existing
 	updated.Bio = patch.Bio
-	mockRepo.On("FindById", authorID).Return(existing, nil).Once()
-	mockRepo.On("Update", mock.Anything, patch).Return(patch, nil).Once()
-	mockRepo.On("FindById", authorID).Return(&updated, nil).Once()
+	mockRepo.On("Update", mock.Anything, patch).Return(&updated, nil).Once()
 
 	result, err := app.UpdateAuthorUseCase(t.Context(), authorID, patch)
 
@@ -71,10 +70,8 @@ func TestGivenWrongAuthorWhenAppUpdateInDataBaseThenReturnError(t *testing.T) {
 	svc := service.NewAuthorService(mockRepo)
 	app := application.NewAuthorUseCase(svc)
 	patch := builder.UpdateAuthorBuilder().Build()
-	existing := builder.NewAuthorBuilder().Build()
 	errorExpected := errors.New("error updating into DB")
-	mockRepo.On("FindById", authorID).Return(existing, nil).Once()
-	mockRepo.On("Update", authorID, mock.AnythingOfType("*model.Author")).Return(&model.Author{}, errorExpected).Once()
+	mockRepo.On("Update", authorID, mock.AnythingOfType("*model.Author")).Return((*model.Author)(nil), errorExpected).Once()
 
 	result, err := app.UpdateAuthorUseCase(t.Context(), authorID, patch)
 
@@ -86,6 +83,7 @@ func TestGivenWrongAuthorWhenAppUpdateInDataBaseThenReturnError(t *testing.T) {
 }
 
 func TestGivenAuthorsInDBWhenAppGetAuthorsThenReturnList(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
 	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
 	svc := service.NewAuthorService(mockRepo)
 	app := application.NewAuthorUseCase(svc)
@@ -95,24 +93,25 @@ func TestGivenAuthorsInDBWhenAppGetAuthorsThenReturnList(t *testing.T) {
 		firstAuthor,
 		secondAuthor,
 	}
-	mockRepo.On("FindAll").Return(expectedAuthors, nil)
+	mockRepo.On("FindAll", query).Return(&port.Page[model.Author]{Items: expectedAuthors}, nil)
 
-	result, err := app.GetAuthorsUseCase(t.Context())
+	result, err := app.GetAuthorsUseCase(t.Context(), query)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, expectedAuthors, result)
+	assert.Equal(t, expectedAuthors, result.Items)
 	mockRepo.AssertExpectations(t)
 }
 
 func TestGivenErrorWhenAppGetAuthorsThenReturnError(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
 	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
 	svc := service.NewAuthorService(mockRepo)
 	app := application.NewAuthorUseCase(svc)
 	expectedError := errors.New("error fetching authors")
-	mockRepo.On("FindAll").Return([]model.Author{}, expectedError)
+	mockRepo.On("FindAll", query).Return((*port.Page[model.Author])(nil), expectedError)
 
-	result, err := app.GetAuthorsUseCase(t.Context())
+	result, err := app.GetAuthorsUseCase(t.Context(), query)
 
 	assert.Empty(t, result)
 	assert.NotNil(t, err)
@@ -151,3 +150,45 @@ func TestGivenInvalidIDWhenAppGetAuthorThenReturnError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGivenLinkedAuthorWhenAppDeleteAuthorThenReturnHasBooks(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	svc := service.NewAuthorService(mockRepo)
+	app := application.NewAuthorUseCase(svc)
+	id := uuid.New()
+	mockRepo.On("Delete", id, false).Return(model.ErrAuthorHasBooks)
+
+	err := app.DeleteAuthorUseCase(t.Context(), id, false)
+
+	assert.ErrorIs(t, err, model.ErrAuthorHasBooks)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenDeletedAuthorWhenAppRestoreAuthorThenReturnAuthor(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	svc := service.NewAuthorService(mockRepo)
+	app := application.NewAuthorUseCase(svc)
+	author := builder.NewAuthorBuilder().Build()
+	mockRepo.On("Restore", author.ID).Return(author, nil)
+
+	result, err := app.RestoreAuthorUseCase(t.Context(), author.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, author, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenPatchWhenAppPatchAuthorThenReturnPersistedAuthor(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	app := application.NewAuthorUseCase(service.NewAuthorService(mockRepo))
+	author := builder.NewAuthorBuilder().WithBio("").Build()
+	bio := ""
+	patch := &model.AuthorPatch{Bio: &bio}
+	mockRepo.On("Patch", author.ID, patch).Return(author, nil)
+
+	result, err := app.PatchAuthorUseCase(t.Context(), author.ID, patch)
+
+	assert.Nil(t, err)
+	assert.Empty(t, result.Bio)
+	mockRepo.AssertExpectations(t)
+}
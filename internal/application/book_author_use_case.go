@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+type BookAuthorUseCaseInterface interface {
+	LinkAuthorUseCase(ctx context.Context, link *model.BookAuthor) error
+	UnlinkAuthorUseCase(ctx context.Context, bookID, authorID uuid.UUID) error
+	GetBookAuthorsUseCase(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error)
+	GetAuthorBooksUseCase(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error)
+}
+
+type BookAuthorUseCase struct {
+	service service.BookAuthorServiceInterface
+}
+
+func NewBookAuthorUseCase(service service.BookAuthorServiceInterface) *BookAuthorUseCase {
+	return &BookAuthorUseCase{service}
+}
+
+func (b *BookAuthorUseCase) LinkAuthorUseCase(ctx context.Context, link *model.BookAuthor) error {
+	return b.service.LinkAuthor(ctx, link)
+}
+
+func (b *BookAuthorUseCase) UnlinkAuthorUseCase(ctx context.Context, bookID, authorID uuid.UUID) error {
+	return b.service.UnlinkAuthor(ctx, bookID, authorID)
+}
+
+func (b *BookAuthorUseCase) GetBookAuthorsUseCase(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error) {
+	return b.service.GetBookAuthors(ctx, bookID)
+}
+
+func (b *BookAuthorUseCase) GetAuthorBooksUseCase(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error) {
+	return b.service.GetAuthorBooks(ctx, authorID)
+}
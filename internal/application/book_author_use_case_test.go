@@ -0,0 +1,68 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenLinkWhenAppLinkAuthorThenReturnNilError(t *testing.T) {
+	links, books, authors := new(mockRepo.BookAuthorRepoMock), new(mockRepo.BookRepoMock), new(mockRepo.AuthorRepoMock)
+	app := application.NewBookAuthorUseCase(service.NewBookAuthorService(links, books, authors))
+	link := builder.NewBookAuthorBuilder().WithRole(model.BookAuthorRoleEditor).Build()
+	authors.On("FindById", link.AuthorID).Return(builder.NewAuthorBuilder().Build(), nil)
+	books.On("FindById", link.BookID).Return(builder.NewBookBuilder().Build(), nil)
+	links.On("FindByBook", link.BookID).Return([]model.BookAuthor{}, nil)
+	links.On("Link", link).Return(nil)
+
+	err := app.LinkAuthorUseCase(t.Context(), link)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, link.Position)
+	links.AssertExpectations(t)
+}
+
+func TestGivenLinkWhenAppUnlinkAuthorThenReturnNilError(t *testing.T) {
+	links := new(mockRepo.BookAuthorRepoMock)
+	app := application.NewBookAuthorUseCase(service.NewBookAuthorService(links, nil, nil))
+	bookID, authorID := uuid.New(), uuid.New()
+	links.On("Unlink", bookID, authorID).Return(nil)
+
+	err := app.UnlinkAuthorUseCase(t.Context(), bookID, authorID)
+
+	assert.Nil(t, err)
+	links.AssertExpectations(t)
+}
+
+func TestGivenBookWhenAppGetBookAuthorsThenReturnLinks(t *testing.T) {
+	links, books := new(mockRepo.BookAuthorRepoMock), new(mockRepo.BookRepoMock)
+	app := application.NewBookAuthorUseCase(service.NewBookAuthorService(links, books, nil))
+	book := builder.NewBookBuilder().Build()
+	expected := []model.BookAuthor{*builder.NewBookAuthorBuilder().WithBookID(book.ID).Build()}
+	books.On("FindById", book.ID).Return(book, nil)
+	links.On("FindByBook", book.ID).Return(expected, nil)
+
+	result, err := app.GetBookAuthorsUseCase(t.Context(), book.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestGivenUnknownAuthorWhenAppGetAuthorBooksThenReturnError(t *testing.T) {
+	authors := new(mockRepo.AuthorRepoMock)
+	app := application.NewBookAuthorUseCase(service.NewBookAuthorService(nil, nil, authors))
+	id := uuid.New()
+	authors.On("FindById", id).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+
+	result, err := app.GetAuthorBooksUseCase(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
+}
@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+type BookCopyUseCaseInterface interface {
+	RegisterCopyUseCase(ctx context.Context, bookCopy *model.BookCopy) error
+	GetCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	GetCopyByBarcodeUseCase(ctx context.Context, barcode string) (*model.BookCopy, error)
+	GetBookCopiesUseCase(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error)
+	RetireCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	ReactivateCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	DeleteCopyUseCase(ctx context.Context, id uuid.UUID) error
+	RestoreCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+}
+
+type BookCopyUseCase struct {
+	service service.BookCopyServiceInterface
+}
+
+func NewBookCopyUseCase(service service.BookCopyServiceInterface) *BookCopyUseCase {
+	return &BookCopyUseCase{service}
+}
+
+func (b *BookCopyUseCase) RegisterCopyUseCase(ctx context.Context, bookCopy *model.BookCopy) error {
+	return b.service.RegisterCopy(ctx, bookCopy)
+}
+
+func (b *BookCopyUseCase) GetCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return b.service.GetCopy(ctx, id)
+}
+
+func (b *BookCopyUseCase) GetCopyByBarcodeUseCase(ctx context.Context, barcode string) (*model.BookCopy, error) {
+	return b.service.GetCopyByBarcode(ctx, barcode)
+}
+
+func (b *BookCopyUseCase) GetBookCopiesUseCase(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error) {
+	return b.service.GetBookCopies(ctx, bookID)
+}
+
+func (b *BookCopyUseCase) RetireCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return b.service.RetireCopy(ctx, id)
+}
+
+func (b *BookCopyUseCase) ReactivateCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return b.service.ReactivateCopy(ctx, id)
+}
+
+func (b *BookCopyUseCase) DeleteCopyUseCase(ctx context.Context, id uuid.UUID) error {
+	return b.service.DeleteCopy(ctx, id)
+}
+
+func (b *BookCopyUseCase) RestoreCopyUseCase(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return b.service.RestoreCopy(ctx, id)
+}
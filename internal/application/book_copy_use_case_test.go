@@ -0,0 +1,98 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenCopyWhenAppRegisterCopyThenReturnNilError(t *testing.T) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil))
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	books.On("FindById", bookCopy.BookID).Return(builder.NewBookBuilder().Build(), nil)
+	copies.On("Save", bookCopy).Return(nil)
+
+	err := app.RegisterCopyUseCase(t.Context(), bookCopy)
+
+	assert.Nil(t, err)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyWhenAppGetCopyThenReturnCopy(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil))
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	copies.On("FindById", bookCopy.ID).Return(bookCopy, nil)
+
+	result, err := app.GetCopyUseCase(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, bookCopy, result)
+}
+
+func TestGivenUnknownBarcodeWhenAppGetCopyByBarcodeThenReturnNotFound(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil))
+	copies.On("FindByBarcode", "missing").Return((*model.BookCopy)(nil), model.ErrCopyNotFound)
+
+	result, err := app.GetCopyByBarcodeUseCase(t.Context(), "missing")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyNotFound)
+}
+
+func TestGivenBookWhenAppGetBookCopiesThenReturnCopies(t *testing.T) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil))
+	book := builder.NewBookBuilder().Build()
+	expected := []model.BookCopy{*builder.NewBookCopyBuilder().WithBookID(book.ID).Build()}
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("FindByBook", book.ID).Return(expected, nil)
+
+	result, err := app.GetBookCopiesUseCase(t.Context(), book.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestGivenCopyWhenAppRetireAndReactivateThenToggleActive(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil))
+	active := builder.NewBookCopyBuilder().Build()
+	retired := builder.NewBookCopyBuilder().WithID(active.ID).WithActive(false).WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", active.ID).Return(active, nil)
+	copies.On("SetActive", active.ID, false).Return(retired, nil)
+	copies.On("SetActive", active.ID, true).Return(active, nil)
+
+	retiredResult, retireErr := app.RetireCopyUseCase(t.Context(), active.ID)
+	activeResult, reactivateErr := app.ReactivateCopyUseCase(t.Context(), active.ID)
+
+	assert.Nil(t, retireErr)
+	assert.Nil(t, reactivateErr)
+	assert.False(t, retiredResult.IsActive)
+	assert.True(t, activeResult.IsActive)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyWhenAppDeleteAndRestoreThenDelegateToRepository(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil))
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	copies.On("Delete", bookCopy.ID).Return(nil)
+	copies.On("Restore", bookCopy.ID).Return(bookCopy, nil)
+
+	deleteErr := app.DeleteCopyUseCase(t.Context(), bookCopy.ID)
+	restored, restoreErr := app.RestoreCopyUseCase(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, deleteErr)
+	assert.Nil(t, restoreErr)
+	assert.Equal(t, bookCopy, restored)
+	copies.AssertExpectations(t)
+}
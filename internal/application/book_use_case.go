@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+)
+
+type BookUseCaseInterface interface {
+	CreateBookUseCase(ctx context.Context, book *model.Book) error
+	GetBooksUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error)
+	GetBookUseCase(ctx context.Context, id uuid.UUID) (*model.Book, error)
+	UpdateBookUseCase(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error)
+	DeleteBookUseCase(ctx context.Context, id uuid.UUID) error
+	RestoreBookUseCase(ctx context.Context, id uuid.UUID) (*model.Book, error)
+}
+
+type BookUseCase struct {
+	service service.BookServiceInterface
+}
+
+func NewBookUseCase(service service.BookServiceInterface) *BookUseCase {
+	return &BookUseCase{service}
+}
+
+func (b *BookUseCase) CreateBookUseCase(ctx context.Context, book *model.Book) error {
+	return b.service.CreateBook(ctx, book)
+}
+
+func (b *BookUseCase) GetBooksUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error) {
+	return b.service.GetBooks(ctx, query)
+}
+
+func (b *BookUseCase) GetBookUseCase(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	return b.service.GetBook(ctx, id)
+}
+
+func (b *BookUseCase) UpdateBookUseCase(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error) {
+	return b.service.UpdateBook(ctx, id, patch)
+}
+
+func (b *BookUseCase) DeleteBookUseCase(ctx context.Context, id uuid.UUID) error {
+	return b.service.DeleteBook(ctx, id)
+}
+
+func (b *BookUseCase) RestoreBookUseCase(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	return b.service.RestoreBook(ctx, id)
+}
@@ -0,0 +1,81 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockBookRepo "library/internal/test/mock"
+)
+
+func TestGivenBookWhenAppCreateBookThenReturnNilError(t *testing.T) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Save", mock.AnythingOfType("*model.Book")).Return(nil)
+
+	err := app.CreateBookUseCase(t.Context(), book)
+
+	assert.Nil(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenErrorWhenAppGetBooksThenReturnError(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	expectedError := errors.New("error fetching books")
+	mockRepo.On("FindAll", query).Return((*port.Page[model.Book])(nil), expectedError)
+
+	result, err := app.GetBooksUseCase(t.Context(), query)
+
+	assert.Empty(t, result)
+	assert.Equal(t, expectedError, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenValidIDWhenAppGetBookThenReturnBook(t *testing.T) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	expectedBook := builder.NewBookBuilder().Build()
+	mockRepo.On("FindById", expectedBook.ID).Return(expectedBook, nil)
+
+	result, err := app.GetBookUseCase(t.Context(), expectedBook.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBook, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBookWhenAppUpdateBookThenReturnUpdated(t *testing.T) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	patch := builder.NewBookBuilder().WithTitle("Updated").Build()
+	mockRepo.On("Update", patch.ID, patch).Return(patch, nil)
+
+	result, err := app.UpdateBookUseCase(t.Context(), patch.ID, patch)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Updated", result.Title)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBookWhenAppDeleteBookThenReturnNilError(t *testing.T) {
+	id := uuid.New()
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	mockRepo.On("Delete", id).Return(nil)
+
+	err := app.DeleteBookUseCase(t.Context(), id)
+
+	assert.Nil(t, err)
+	mockRepo.AssertExpectations(t)
+}
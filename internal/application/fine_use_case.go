@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+type FineUseCaseInterface interface {
+	GetMemberFinesUseCase(ctx context.Context, memberID uuid.UUID) (*model.FineSummary, error)
+	GetLoanFineUseCase(ctx context.Context, loanID uuid.UUID) (*model.LoanFine, error)
+	RecordFineTransactionUseCase(
+		ctx context.Context,
+		loanID uuid.UUID,
+		transaction *model.FineTransaction,
+	) (*model.FineTransaction, error)
+}
+
+type FineUseCase struct {
+	service service.FineServiceInterface
+}
+
+func NewFineUseCase(service service.FineServiceInterface) *FineUseCase {
+	return &FineUseCase{service}
+}
+
+func (f *FineUseCase) GetMemberFinesUseCase(ctx context.Context, memberID uuid.UUID) (*model.FineSummary, error) {
+	return f.service.MemberFines(ctx, memberID)
+}
+
+func (f *FineUseCase) GetLoanFineUseCase(ctx context.Context, loanID uuid.UUID) (*model.LoanFine, error) {
+	return f.service.LoanFine(ctx, loanID)
+}
+
+func (f *FineUseCase) RecordFineTransactionUseCase(
+	ctx context.Context,
+	loanID uuid.UUID,
+	transaction *model.FineTransaction,
+) (*model.FineTransaction, error) {
+	return f.service.RecordTransaction(ctx, loanID, transaction)
+}
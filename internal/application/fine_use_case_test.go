@@ -0,0 +1,66 @@
+package application_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func newFineUseCase() (*application.FineUseCase, *mockRepo.LoanRepoMock, *mockRepo.MemberRepoMock, *mockRepo.FineTransactionRepoMock) {
+	loans, members, transactions := new(mockRepo.LoanRepoMock), new(mockRepo.MemberRepoMock), new(mockRepo.FineTransactionRepoMock)
+	policy := service.CategoryFinePolicy{}
+	svc := service.NewFineService(mockRepo.TransactionMock{}, loans, members, transactions, policy)
+	return application.NewFineUseCase(svc), loans, members, transactions
+}
+
+func TestGivenMemberWithoutFinesWhenAppGetMemberFinesThenReturnEmptySummary(t *testing.T) {
+	app, loans, members, transactions := newFineUseCase()
+	member := builder.NewMemberBuilder().Build()
+	members.On("FindById", member.ID).Return(member, nil)
+	loans.On("FindByMember", member.ID).Return([]model.Loan{*builder.NewLoanBuilder().Build()}, nil)
+	transactions.On("FindByMember", member.ID).Return([]model.FineTransaction{}, nil)
+
+	summary, err := app.GetMemberFinesUseCase(t.Context(), member.ID)
+
+	assert.Nil(t, err)
+	assert.Empty(t, summary.Loans)
+	assert.Equal(t, 0, summary.OutstandingCents)
+}
+
+func TestGivenClosedLoanWhenAppGetLoanFineThenReturnStoredFine(t *testing.T) {
+	app, loans, members, transactions := newFineUseCase()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(125).Build()
+	loans.On("FindById", loan.ID).Return(loan, nil)
+	members.On("FindById", member.ID).Return(member, nil)
+	transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+
+	fine, err := app.GetLoanFineUseCase(t.Context(), loan.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 125, fine.OutstandingCents)
+}
+
+func TestGivenWaiverWhenAppRecordFineTransactionThenReturnTransaction(t *testing.T) {
+	app, loans, members, transactions := newFineUseCase()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(125).Build()
+	loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	members.On("FindById", member.ID).Return(member, nil)
+	transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+	transactions.On("Save", mock.AnythingOfType("*model.FineTransaction")).Return(nil)
+
+	transaction, err := app.RecordFineTransactionUseCase(t.Context(), loan.ID,
+		&model.FineTransaction{Kind: model.FineTransactionWaiver, AmountCents: 125})
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.FineTransactionWaiver, transaction.Kind)
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+type LoanUseCaseInterface interface {
+	CheckoutUseCase(ctx context.Context, memberID, copyID uuid.UUID, dueDate *time.Time) (*model.Loan, error)
+	ReturnUseCase(ctx context.Context, copyID uuid.UUID) (*model.Loan, error)
+	RenewUseCase(ctx context.Context, loanID uuid.UUID) (*model.Loan, error)
+	CountOverdueUseCase(ctx context.Context) (int, error)
+}
+
+type LoanUseCase struct {
+	service service.LoanServiceInterface
+}
+
+func NewLoanUseCase(service service.LoanServiceInterface) *LoanUseCase {
+	return &LoanUseCase{service}
+}
+
+func (l *LoanUseCase) CheckoutUseCase(
+	ctx context.Context,
+	memberID, copyID uuid.UUID,
+	dueDate *time.Time,
+) (*model.Loan, error) {
+	return l.service.Checkout(ctx, memberID, copyID, dueDate)
+}
+
+func (l *LoanUseCase) ReturnUseCase(ctx context.Context, copyID uuid.UUID) (*model.Loan, error) {
+	return l.service.Return(ctx, copyID)
+}
+
+func (l *LoanUseCase) RenewUseCase(ctx context.Context, loanID uuid.UUID) (*model.Loan, error) {
+	return l.service.Renew(ctx, loanID)
+}
+
+func (l *LoanUseCase) CountOverdueUseCase(ctx context.Context) (int, error) {
+	return l.service.CountOverdue(ctx)
+}
@@ -0,0 +1,57 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenMemberAndCopyWhenAppCheckoutThenReturnLoan(t *testing.T) {
+	loans, copies, members := new(mockRepo.LoanRepoMock), new(mockRepo.BookCopyRepoMock), new(mockRepo.MemberRepoMock)
+	app := application.NewLoanUseCase(service.NewLoanService(mockRepo.TransactionMock{}, loans, copies, members,
+		new(mockRepo.ReservationRepoMock), service.CategoryFinePolicy{}, service.DefaultLoanPolicy()))
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+
+	loan, err := app.CheckoutUseCase(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, bookCopy.ID, loan.CopyID)
+}
+
+func TestGivenCopyWithoutLoanWhenAppReturnThenReturnError(t *testing.T) {
+	loans := new(mockRepo.LoanRepoMock)
+	app := application.NewLoanUseCase(service.NewLoanService(mockRepo.TransactionMock{}, loans, nil, nil,
+		nil, service.CategoryFinePolicy{}, service.DefaultLoanPolicy()))
+	loan := builder.NewLoanBuilder().Build()
+	loans.On("FindActiveByCopy", loan.CopyID).Return((*model.Loan)(nil), model.ErrCopyNotOnLoan)
+
+	result, err := app.ReturnUseCase(t.Context(), loan.CopyID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyNotOnLoan)
+}
+
+func TestGivenUnknownLoanWhenAppRenewThenReturnNotFound(t *testing.T) {
+	loans := new(mockRepo.LoanRepoMock)
+	app := application.NewLoanUseCase(service.NewLoanService(mockRepo.TransactionMock{}, loans, nil, nil,
+		nil, service.CategoryFinePolicy{}, service.DefaultLoanPolicy()))
+	loan := builder.NewLoanBuilder().Build()
+	loans.On("FindByIdForUpdate", loan.ID).Return((*model.Loan)(nil), model.ErrLoanNotFound)
+
+	result, err := app.RenewUseCase(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrLoanNotFound)
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+)
+
+type MemberUseCaseInterface interface {
+	RegisterMemberUseCase(ctx context.Context, member *model.Member) error
+	GetMembersUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error)
+	GetMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	GetMemberByEmailUseCase(ctx context.Context, email string) (*model.Member, error)
+	GetMemberDetailUseCase(ctx context.Context, id uuid.UUID) (*model.MemberDetail, error)
+	UpdateMemberUseCase(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error)
+	ActivateMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	DeactivateMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	DeleteMemberUseCase(ctx context.Context, id uuid.UUID) error
+	RestoreMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error)
+}
+
+type MemberUseCase struct {
+	service service.MemberServiceInterface
+}
+
+func NewMemberUseCase(service service.MemberServiceInterface) *MemberUseCase {
+	return &MemberUseCase{service}
+}
+
+func (m *MemberUseCase) RegisterMemberUseCase(ctx context.Context, member *model.Member) error {
+	return m.service.RegisterMember(ctx, member)
+}
+
+func (m *MemberUseCase) GetMembersUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error) {
+	return m.service.GetMembers(ctx, query)
+}
+
+func (m *MemberUseCase) GetMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return m.service.GetMember(ctx, id)
+}
+
+func (m *MemberUseCase) GetMemberByEmailUseCase(ctx context.Context, email string) (*model.Member, error) {
+	return m.service.GetMemberByEmail(ctx, email)
+}
+
+func (m *MemberUseCase) GetMemberDetailUseCase(ctx context.Context, id uuid.UUID) (*model.MemberDetail, error) {
+	return m.service.GetMemberDetail(ctx, id)
+}
+
+func (m *MemberUseCase) UpdateMemberUseCase(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error) {
+	return m.service.UpdateMember(ctx, id, patch)
+}
+
+func (m *MemberUseCase) ActivateMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return m.service.ActivateMember(ctx, id)
+}
+
+func (m *MemberUseCase) DeactivateMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return m.service.DeactivateMember(ctx, id)
+}
+
+func (m *MemberUseCase) DeleteMemberUseCase(ctx context.Context, id uuid.UUID) error {
+	return m.service.DeleteMember(ctx, id)
+}
+
+func (m *MemberUseCase) RestoreMemberUseCase(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return m.service.RestoreMember(ctx, id)
+}
@@ -0,0 +1,50 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenMemberWhenAppRegisterMemberThenReturnNilError(t *testing.T) {
+	members, categories := new(mockRepo.MemberRepoMock), new(mockRepo.MembershipCategoryRepoMock)
+	app := application.NewMemberUseCase(service.NewMemberService(members, categories, nil, nil, nil))
+	member := builder.NewMemberBuilder().Build()
+	categories.On("FindByName", member.Category).
+		Return(builder.NewMembershipCategoryBuilder().WithName(member.Category).Build(), nil)
+	members.On("Save", member).Return(nil)
+
+	err := app.RegisterMemberUseCase(t.Context(), member)
+
+	assert.Nil(t, err)
+	members.AssertExpectations(t)
+}
+
+func TestGivenUnknownEmailWhenAppGetMemberByEmailThenReturnNotFound(t *testing.T) {
+	members := new(mockRepo.MemberRepoMock)
+	app := application.NewMemberUseCase(service.NewMemberService(members, nil, nil, nil, nil))
+	members.On("FindByEmail", "missing@example.com").Return((*model.Member)(nil), model.ErrMemberNotFound)
+
+	result, err := app.GetMemberByEmailUseCase(t.Context(), "missing@example.com")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+}
+
+func TestGivenInactiveMemberWhenAppActivateMemberThenReturnActive(t *testing.T) {
+	members := new(mockRepo.MemberRepoMock)
+	app := application.NewMemberUseCase(service.NewMemberService(members, nil, nil, nil, nil))
+	member := builder.NewMemberBuilder().Build()
+	members.On("SetActive", member.ID, true).Return(member, nil)
+
+	result, err := app.ActivateMemberUseCase(t.Context(), member.ID)
+
+	assert.Nil(t, err)
+	assert.True(t, result.IsActive)
+}
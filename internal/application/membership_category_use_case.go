@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+)
+
+type MembershipCategoryUseCaseInterface interface {
+	CreateCategoryUseCase(ctx context.Context, category *model.MembershipCategory) error
+	GetCategoriesUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.MembershipCategory], error)
+	GetCategoryUseCase(ctx context.Context, name string) (*model.MembershipCategory, error)
+	UpdateCategoryUseCase(
+		ctx context.Context,
+		name string,
+		category *model.MembershipCategory,
+	) (*model.MembershipCategory, error)
+}
+
+type MembershipCategoryUseCase struct {
+	service service.MembershipCategoryServiceInterface
+}
+
+func NewMembershipCategoryUseCase(service service.MembershipCategoryServiceInterface) *MembershipCategoryUseCase {
+	return &MembershipCategoryUseCase{service}
+}
+
+func (m *MembershipCategoryUseCase) CreateCategoryUseCase(ctx context.Context, category *model.MembershipCategory) error {
+	return m.service.CreateCategory(ctx, category)
+}
+
+func (m *MembershipCategoryUseCase) GetCategoriesUseCase(ctx context.Context, query port.ListQuery) (*port.Page[model.MembershipCategory], error) {
+	return m.service.GetCategories(ctx, query)
+}
+
+func (m *MembershipCategoryUseCase) GetCategoryUseCase(ctx context.Context, name string) (*model.MembershipCategory, error) {
+	return m.service.GetCategory(ctx, name)
+}
+
+func (m *MembershipCategoryUseCase) UpdateCategoryUseCase(
+	ctx context.Context,
+	name string,
+	category *model.MembershipCategory,
+) (*model.MembershipCategory, error) {
+	return m.service.UpdateCategory(ctx, name, category)
+}
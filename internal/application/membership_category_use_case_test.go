@@ -0,0 +1,51 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenCategoryWhenAppCreateCategoryThenReturnNilError(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	app := application.NewMembershipCategoryUseCase(service.NewMembershipCategoryService(repo))
+	category := builder.NewMembershipCategoryBuilder().Build()
+	repo.On("Save", category).Return(nil)
+
+	err := app.CreateCategoryUseCase(t.Context(), category)
+
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestGivenCategoriesWhenAppGetCategoriesThenReturnAll(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	app := application.NewMembershipCategoryUseCase(service.NewMembershipCategoryService(repo))
+	categories := []model.MembershipCategory{*builder.NewMembershipCategoryBuilder().Build()}
+	repo.On("FindAll", query).Return(&port.Page[model.MembershipCategory]{Items: categories}, nil)
+
+	result, err := app.GetCategoriesUseCase(t.Context(), query)
+
+	assert.Nil(t, err)
+	assert.Len(t, result.Items, 1)
+}
+
+func TestGivenUnknownNameWhenAppUpdateCategoryThenReturnNotFound(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	app := application.NewMembershipCategoryUseCase(service.NewMembershipCategoryService(repo))
+	terms := builder.NewMembershipCategoryBuilder().Build()
+	repo.On("Update", "vip", terms).Return((*model.MembershipCategory)(nil), model.ErrMembershipCategoryNotFound)
+
+	result, err := app.UpdateCategoryUseCase(t.Context(), "vip", terms)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryNotFound)
+}
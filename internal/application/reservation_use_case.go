@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+type ReservationUseCaseInterface interface {
+	PlaceHoldUseCase(ctx context.Context, bookID, memberID uuid.UUID) (*model.Reservation, error)
+	GetReservationUseCase(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	GetBookQueueUseCase(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error)
+	CancelHoldUseCase(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	GetHoldShelfUseCase(ctx context.Context) ([]model.Reservation, error)
+	ExpireStaleHoldsUseCase(ctx context.Context) (int, error)
+}
+
+type ReservationUseCase struct {
+	service service.ReservationServiceInterface
+}
+
+func NewReservationUseCase(service service.ReservationServiceInterface) *ReservationUseCase {
+	return &ReservationUseCase{service}
+}
+
+func (r *ReservationUseCase) PlaceHoldUseCase(ctx context.Context, bookID, memberID uuid.UUID) (*model.Reservation, error) {
+	return r.service.PlaceHold(ctx, bookID, memberID)
+}
+
+func (r *ReservationUseCase) GetReservationUseCase(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	return r.service.GetReservation(ctx, id)
+}
+
+func (r *ReservationUseCase) GetBookQueueUseCase(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error) {
+	return r.service.GetBookQueue(ctx, bookID)
+}
+
+func (r *ReservationUseCase) CancelHoldUseCase(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	return r.service.CancelHold(ctx, id)
+}
+
+func (r *ReservationUseCase) GetHoldShelfUseCase(ctx context.Context) ([]model.Reservation, error) {
+	return r.service.GetHoldShelf(ctx)
+}
+
+func (r *ReservationUseCase) ExpireStaleHoldsUseCase(ctx context.Context) (int, error) {
+	return r.service.ExpireStaleHolds(ctx)
+}
@@ -0,0 +1,43 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenBookWithQueueWhenAppGetBookQueueThenReturnReservations(t *testing.T) {
+	repo, books := new(mockRepo.ReservationRepoMock), new(mockRepo.BookRepoMock)
+	app := application.NewReservationUseCase(service.NewReservationService(
+		mockRepo.TransactionMock{}, repo, books, new(mockRepo.MemberRepoMock), nil, service.DefaultLoanPolicy()))
+	book := builder.NewBookBuilder().Build()
+	head := builder.NewReservationBuilder().WithBookID(book.ID).Build()
+	head.Position = 1
+	books.On("FindById", book.ID).Return(book, nil)
+	repo.On("FindActiveByBook", book.ID).Return([]model.Reservation{*head}, nil)
+
+	queue, err := app.GetBookQueueUseCase(t.Context(), book.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, queue, 1)
+	assert.Equal(t, 1, queue[0].Position)
+}
+
+func TestGivenUnknownReservationWhenAppCancelHoldThenReturnNotFound(t *testing.T) {
+	repo := new(mockRepo.ReservationRepoMock)
+	app := application.NewReservationUseCase(service.NewReservationService(
+		mockRepo.TransactionMock{}, repo, nil, nil, nil, service.DefaultLoanPolicy()))
+	reservation := builder.NewReservationBuilder().Build()
+	repo.On("FindById", reservation.ID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+
+	result, err := app.CancelHoldUseCase(t.Context(), reservation.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrReservationNotFound)
+}
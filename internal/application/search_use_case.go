@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+)
+
+type SearchUseCaseInterface interface {
+	SearchUseCase(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error)
+}
+
+type SearchUseCase struct {
+	service service.SearchServiceInterface
+}
+
+func NewSearchUseCase(service service.SearchServiceInterface) *SearchUseCase {
+	return &SearchUseCase{service}
+}
+
+func (s *SearchUseCase) SearchUseCase(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error) {
+	return s.service.Search(ctx, query)
+}
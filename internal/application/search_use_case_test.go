@@ -0,0 +1,25 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenQueryWhenAppSearchThenReturnResult(t *testing.T) {
+	query := port.SearchQuery{Text: "dune", Limit: port.DefaultListLimit, Page: 1}
+	repo := new(mockRepo.SearchRepoMock)
+	app := application.NewSearchUseCase(service.NewSearchService(repo))
+	repo.On("SearchBooks", query).Return(&model.SearchResult{Total: 3}, nil)
+
+	result, err := app.SearchUseCase(t.Context(), query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), result.Total)
+}
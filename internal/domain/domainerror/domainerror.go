@@ -0,0 +1,86 @@
+// Package domainerror classifies the errors of the library domain so that the
+// transport can report them without knowing each one.
+package domainerror
+
+import "errors"
+
+// Kind tells what went wrong. Errors that carry no Kind are Internal.
+type Kind int
+
+const (
+	Internal Kind = iota
+	NotFound
+	Conflict
+	Validation
+	Forbidden
+)
+
+// Error is a domain error. Code is a stable, machine readable identifier and
+// Message is safe to show to clients. Sentinels are compared by identity, so
+// errors.Is tells two errors of the same Kind apart.
+type Error struct {
+	kind    Kind
+	code    string
+	message string
+	err     error
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{kind: kind, code: code, message: message}
+}
+
+// Wrap returns an error of kind that keeps err as its cause. The cause is for
+// logs and errors.Is, it is never shown to clients.
+func Wrap(kind Kind, code, message string, err error) *Error {
+	return &Error{kind: kind, code: code, message: message, err: err}
+}
+
+func NewNotFound(code, message string) *Error {
+	return New(NotFound, code, message)
+}
+
+func NewConflict(code, message string) *Error {
+	return New(Conflict, code, message)
+}
+
+func NewValidation(code, message string) *Error {
+	return New(Validation, code, message)
+}
+
+func NewForbidden(code, message string) *Error {
+	return New(Forbidden, code, message)
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
+
+// As returns the first domain error in the chain of err.
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
+
+// KindOf returns the Kind of the first domain error in the chain of err, or
+// Internal when there is none.
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.kind
+	}
+	return Internal
+}
@@ -0,0 +1,41 @@
+package domainerror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/domainerror"
+)
+
+func TestGivenWrappedDomainErrorWhenKindOfThenReturnItsKind(t *testing.T) {
+	notFound := domainerror.NewNotFound("thing_not_found", "thing not found")
+
+	err := fmt.Errorf("loading thing: %w", notFound)
+
+	assert.Equal(t, domainerror.NotFound, domainerror.KindOf(err))
+	assert.ErrorIs(t, err, notFound)
+}
+
+func TestGivenPlainErrorWhenKindOfThenReturnInternal(t *testing.T) {
+	assert.Equal(t, domainerror.Internal, domainerror.KindOf(errors.New("boom")))
+}
+
+func TestGivenSentinelsOfSameKindWhenIsThenTellThemApart(t *testing.T) {
+	first := domainerror.NewConflict("first", "first conflict")
+	second := domainerror.NewConflict("second", "second conflict")
+
+	assert.NotErrorIs(t, first, second)
+}
+
+func TestGivenCauseWhenWrapThenKeepCauseButShowMessage(t *testing.T) {
+	cause := errors.New("pq: value too long")
+
+	err := domainerror.Wrap(domainerror.Validation, "invalid_value", "value out of range", cause)
+
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "value out of range", err.Error())
+	assert.Equal(t, "invalid_value", err.Code())
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Book represents the books table
@@ -13,19 +14,22 @@ import (
 //   title TEXT NOT NULL,
 //   isbn TEXT UNIQUE,
 //   description TEXT,
-//   published_year INT,
+//   published_year INT NOT NULL DEFAULT 0,
 //   created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-//   updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+//   updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//   deleted_at TIMESTAMPTZ
 // )
+// Deleted books are hidden from queries; the ISBN is only unique among live books
 
 type Book struct {
-	ID            uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
-	Title         string    `json:"title" gorm:"not null"`
-	ISBN          string    `json:"isbn" gorm:"uniqueIndex"`
-	Description   string    `json:"description"`
-	PublishedYear int       `json:"published_year"`
-	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID            uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	Title         string         `json:"title" gorm:"not null"`
+	ISBN          string         `json:"isbn" gorm:"uniqueIndex:uniq_live_book_isbn,where:deleted_at IS NULL"`
+	Description   string         `json:"description"`
+	PublishedYear int            `json:"published_year"`
+	CreatedAt     time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 
 	// Relations
 	Authors []Author `json:"authors,omitempty" gorm:"many2many:book_authors;"`
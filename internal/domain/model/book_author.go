@@ -3,11 +3,32 @@ package model
 import "github.com/google/uuid"
 
 // BookAuthor represents the pivot table book_authors for many-to-many between books and authors
-// Primary key is composite (book_id, author_id)
+// Primary key is composite (book_id, author_id); role and position describe the byline
+
+const (
+	BookAuthorRoleAuthor      = "author"
+	BookAuthorRoleEditor      = "editor"
+	BookAuthorRoleTranslator  = "translator"
+	BookAuthorRoleIllustrator = "illustrator"
+)
 
 type BookAuthor struct {
 	BookID   uuid.UUID `json:"book_id" gorm:"type:uuid;primaryKey"`
 	AuthorID uuid.UUID `json:"author_id" gorm:"type:uuid;primaryKey"`
+	Role     string    `json:"role" gorm:"not null;default:author"`
+	Position int       `json:"position" gorm:"not null;default:1"`
+	Book     *Book     `json:"book,omitempty" gorm:"foreignKey:BookID;references:ID;constraint:OnDelete:CASCADE"`
+	Author   *Author   `json:"author,omitempty" gorm:"foreignKey:AuthorID;references:ID;constraint:OnDelete:CASCADE"`
 }
 
 func (BookAuthor) TableName() string { return "book_authors" }
+
+// IsValidBookAuthorRole reports whether role is one of the supported byline roles.
+func IsValidBookAuthorRole(role string) bool {
+	switch role {
+	case BookAuthorRoleAuthor, BookAuthorRoleEditor, BookAuthorRoleTranslator, BookAuthorRoleIllustrator:
+		return true
+	default:
+		return false
+	}
+}
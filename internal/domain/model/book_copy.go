@@ -4,17 +4,22 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // BookCopy represents the book_copies table
 // Schema: library.book_copies
+// Available is not a column: it is derived from is_active and the absence of an open loan
+// Deleting a book also deletes its copies, with the same deleted_at so they are restored together
 
 type BookCopy struct {
-	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
-	BookID    uuid.UUID `json:"book_id" gorm:"type:uuid;not null"`
-	Book      *Book     `json:"book,omitempty" gorm:"foreignKey:BookID;references:ID;constraint:OnDelete:CASCADE"`
-	Barcode   string    `json:"barcode" gorm:"uniqueIndex"`
-	Condition string    `json:"condition"`
-	IsActive  bool      `json:"is_active" gorm:"not null;default:true"`
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	ID        uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	BookID    uuid.UUID      `json:"book_id" gorm:"type:uuid;not null"`
+	Book      *Book          `json:"book,omitempty" gorm:"foreignKey:BookID;references:ID;constraint:OnDelete:CASCADE"`
+	Barcode   string         `json:"barcode" gorm:"uniqueIndex:uniq_live_copy_barcode,where:deleted_at IS NULL"`
+	Condition string         `json:"condition"`
+	IsActive  bool           `json:"is_active" gorm:"not null;default:true"`
+	Available bool           `json:"available" gorm:"->;-:migration"`
+	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
@@ -0,0 +1,59 @@
+package model
+
+import "library/internal/domain/domainerror"
+
+var (
+	ErrInvalidSort   = domainerror.NewValidation("invalid_sort", "the list cannot be sorted by that field")
+	ErrInvalidFilter = domainerror.NewValidation("invalid_filter", "the list cannot be filtered by that field or value")
+	ErrInvalidCursor = domainerror.NewValidation("invalid_cursor", "cursor is malformed or was issued for another sort")
+
+	ErrInvalidSearchQuery = domainerror.NewValidation("invalid_search_query", "search needs at least one word and at most 200 characters")
+
+	ErrAuthorNotFound    = domainerror.NewNotFound("author_not_found", "author not found")
+	ErrAuthorHasBooks    = domainerror.NewConflict("author_has_books", "author is linked to books, unlink them or delete with force")
+	ErrInvalidAuthorName = domainerror.NewValidation("invalid_author_name", "author first and last name are required")
+
+	ErrBookNotFound      = domainerror.NewNotFound("book_not_found", "book not found")
+	ErrBookISBNConflict  = domainerror.NewConflict("book_isbn_conflict", "a book with the same ISBN already exists")
+	ErrBookInCirculation = domainerror.NewConflict("book_in_circulation", "book has copies on loan or active reservations")
+
+	ErrBookAuthorAlreadyLinked = domainerror.NewConflict("book_author_already_linked", "author is already linked to the book")
+	ErrBookAuthorNotLinked     = domainerror.NewNotFound("book_author_not_linked", "author is not linked to the book")
+	ErrInvalidBookAuthorRole   = domainerror.NewValidation("invalid_book_author_role", "invalid author role, expected author, editor, translator or illustrator")
+
+	ErrCopyNotFound        = domainerror.NewNotFound("copy_not_found", "book copy not found")
+	ErrCopyBarcodeConflict = domainerror.NewConflict("copy_barcode_conflict", "a book copy with the same barcode already exists")
+	ErrCopyAlreadyOnLoan   = domainerror.NewConflict("copy_already_on_loan", "copy already on loan")
+	ErrCopyInactive        = domainerror.NewConflict("copy_inactive", "book copy is retired and cannot be lent")
+	ErrCopyNotOnLoan       = domainerror.NewConflict("copy_not_on_loan", "book copy has no active loan")
+	ErrCopyInCirculation   = domainerror.NewConflict("copy_in_circulation", "book copy is on loan or on the hold shelf")
+
+	ErrMemberNotFound      = domainerror.NewNotFound("member_not_found", "member not found")
+	ErrMemberInactive      = domainerror.NewForbidden("member_inactive", "member is inactive")
+	ErrMemberEmailConflict = domainerror.NewConflict("member_email_conflict", "a member with the same email already exists")
+	ErrMemberHasOpenLoans  = domainerror.NewConflict("member_has_open_loans", "member has open loans or active reservations")
+	ErrInvalidMemberName   = domainerror.NewValidation("invalid_member_name", "member full name is required")
+	ErrInvalidMemberEmail  = domainerror.NewValidation("invalid_member_email", "member email is missing or invalid")
+
+	ErrMembershipCategoryNotFound = domainerror.NewNotFound("membership_category_not_found", "membership category not found")
+	ErrMembershipCategoryConflict = domainerror.NewConflict("membership_category_conflict", "a membership category with the same name already exists")
+	ErrInvalidMembershipCategory  = domainerror.NewValidation("invalid_membership_category", "invalid membership category, max loans and loan period must be positive and no value may be negative")
+
+	ErrLoanNotFound          = domainerror.NewNotFound("loan_not_found", "loan not found")
+	ErrLoanAlreadyReturned   = domainerror.NewConflict("loan_already_returned", "loan has already been returned")
+	ErrRenewalLimitReached   = domainerror.NewConflict("renewal_limit_reached", "loan has reached its renewal limit")
+	ErrLoanOverdue           = domainerror.NewConflict("loan_overdue", "loan is too overdue to be renewed")
+	ErrBookReservedByAnother = domainerror.NewConflict("book_reserved_by_another", "book is reserved by another member")
+	ErrInvalidDueDate        = domainerror.NewValidation("invalid_due_date", "due date must be in the future")
+	ErrLoanLimitReached      = domainerror.NewConflict("loan_limit_reached", "member has reached the loan limit of their membership category")
+
+	ErrReservationNotFound      = domainerror.NewNotFound("reservation_not_found", "reservation not found")
+	ErrReservationAlreadyActive = domainerror.NewConflict("reservation_already_active", "member already has an active reservation for this book")
+	ErrReservationNotActive     = domainerror.NewConflict("reservation_not_active", "reservation is no longer active")
+	ErrCopyOnHoldShelf          = domainerror.NewConflict("copy_on_hold_shelf", "copy is on the hold shelf for another member")
+	ErrCopyHeldForPickup        = domainerror.NewConflict("copy_held_for_pickup", "copy is on the hold shelf, cancel the hold before retiring it")
+
+	ErrInvalidFineTransactionKind = domainerror.NewValidation("invalid_fine_transaction_kind", "invalid fine transaction kind, expected payment or waiver")
+	ErrInvalidFineAmount          = domainerror.NewValidation("invalid_fine_amount", "fine transaction amount must be greater than zero")
+	ErrFineAmountExceedsBalance   = domainerror.NewConflict("fine_amount_exceeds_balance", "amount exceeds the outstanding fine")
+)
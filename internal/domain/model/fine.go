@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FineTransaction represents the fine_transactions table
+// Schema: library.fine_transactions
+// Each row settles part of a loan fine, either paid by the member or waived by staff
+
+const (
+	FineTransactionPayment = "payment"
+	FineTransactionWaiver  = "waiver"
+)
+
+type FineTransaction struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	LoanID      uuid.UUID `json:"loan_id" gorm:"type:uuid;not null;index"`
+	Loan        *Loan     `json:"loan,omitempty" gorm:"foreignKey:LoanID;references:ID;constraint:OnDelete:RESTRICT"`
+	MemberID    uuid.UUID `json:"member_id" gorm:"type:uuid;not null;index"`
+	Kind        string    `json:"kind" gorm:"not null"`
+	AmountCents int       `json:"amount_cents" gorm:"not null"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (FineTransaction) TableName() string { return "fine_transactions" }
+
+// IsValidFineTransactionKind reports whether kind is a payment or a waiver.
+func IsValidFineTransactionKind(kind string) bool {
+	return kind == FineTransactionPayment || kind == FineTransactionWaiver
+}
+
+// LoanFine is the fine position of a single loan. Accruing is true while the
+// loan is still open, in which case FineCents is the amount owed so far.
+type LoanFine struct {
+	LoanID           uuid.UUID  `json:"loan_id"`
+	CopyID           uuid.UUID  `json:"copy_id"`
+	DueDate          time.Time  `json:"due_date"`
+	ReturnedAt       *time.Time `json:"returned_at"`
+	Accruing         bool       `json:"accruing"`
+	FineCents        int        `json:"fine_cents"`
+	PaidCents        int        `json:"paid_cents"`
+	WaivedCents      int        `json:"waived_cents"`
+	OutstandingCents int        `json:"outstanding_cents"`
+}
+
+// FineSummary aggregates the fines of every loan of a member.
+type FineSummary struct {
+	MemberID         uuid.UUID  `json:"member_id"`
+	FineCents        int        `json:"fine_cents"`
+	PaidCents        int        `json:"paid_cents"`
+	WaivedCents      int        `json:"waived_cents"`
+	OutstandingCents int        `json:"outstanding_cents"`
+	Loans            []LoanFine `json:"loans"`
+}
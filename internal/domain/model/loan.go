@@ -8,16 +8,18 @@ import (
 
 // Loan represents the loans table
 // Schema: library.loans
+// RenewalCount tracks how many times the due date has been extended
 
 type Loan struct {
-	ID         uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
-	MemberID   uuid.UUID  `json:"member_id" gorm:"type:uuid;not null"`
-	Member     *Member    `json:"member,omitempty" gorm:"foreignKey:MemberID;references:ID;constraint:OnDelete:RESTRICT"`
-	CopyID     uuid.UUID  `json:"copy_id" gorm:"type:uuid;not null"`
-	Copy       *BookCopy  `json:"copy,omitempty" gorm:"foreignKey:CopyID;references:ID;constraint:OnDelete:RESTRICT"`
-	LoanedAt   time.Time  `json:"loaned_at" gorm:"autoCreateTime"`
-	DueDate    time.Time  `json:"due_date"`
-	ReturnedAt *time.Time `json:"returned_at"` // nullable
-	FineCents  int        `json:"fine_cents" gorm:"not null;default:0"`
-	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	ID           uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	MemberID     uuid.UUID  `json:"member_id" gorm:"type:uuid;not null"`
+	Member       *Member    `json:"member,omitempty" gorm:"foreignKey:MemberID;references:ID;constraint:OnDelete:RESTRICT"`
+	CopyID       uuid.UUID  `json:"copy_id" gorm:"type:uuid;not null"`
+	Copy         *BookCopy  `json:"copy,omitempty" gorm:"foreignKey:CopyID;references:ID;constraint:OnDelete:RESTRICT"`
+	LoanedAt     time.Time  `json:"loaned_at" gorm:"autoCreateTime"`
+	DueDate      time.Time  `json:"due_date"`
+	ReturnedAt   *time.Time `json:"returned_at"` // nullable
+	FineCents    int        `json:"fine_cents" gorm:"not null;default:0"`
+	RenewalCount int        `json:"renewal_count" gorm:"not null;default:0"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
 }
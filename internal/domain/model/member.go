@@ -4,17 +4,36 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Member represents the members table
 // Schema: library.members
+// Category names the membership category that sets the member's loan limit,
+// loan period, renewal limit and overdue fine
+// Deleted members are hidden from queries; the email is only unique among live members
+
+const DefaultMemberCategory = "standard"
 
 type Member struct {
-	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
-	FullName  string    `json:"full_name" gorm:"not null"`
-	Email     string    `json:"email" gorm:"uniqueIndex"`
-	Phone     string    `json:"phone"`
-	IsActive  bool      `json:"is_active" gorm:"not null;default:true"`
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID                 uuid.UUID           `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	FullName           string              `json:"full_name" gorm:"not null"`
+	Email              string              `json:"email" gorm:"uniqueIndex:uniq_live_member_email,where:deleted_at IS NULL"`
+	Phone              string              `json:"phone"`
+	Category           string              `json:"category" gorm:"not null;default:standard"`
+	MembershipCategory *MembershipCategory `json:"membership_category,omitempty" gorm:"foreignKey:Category;references:Name;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	IsActive           bool                `json:"is_active" gorm:"not null;default:true"`
+	CreatedAt          time.Time           `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time           `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt          gorm.DeletedAt      `json:"deleted_at" gorm:"index"`
+}
+
+// MemberDetail is everything the front desk needs to serve a member: the loans
+// still out, the fines not yet settled and the holds still waiting.
+type MemberDetail struct {
+	Member
+	ActiveLoans          []Loan        `json:"active_loans"`
+	OutstandingFineCents int           `json:"outstanding_fine_cents"`
+	OutstandingFines     []LoanFine    `json:"outstanding_fines"`
+	ActiveReservations   []Reservation `json:"active_reservations"`
 }
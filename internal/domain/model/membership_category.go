@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// MembershipCategory represents the membership_categories table
+// Schema: library.membership_categories
+// Each member belongs to one category, which sets their borrowing terms and overdue fine
+
+type MembershipCategory struct {
+	Name               string    `gorm:"primaryKey" json:"name"`
+	MaxLoans           int       `json:"max_loans" gorm:"not null"`
+	LoanPeriodDays     int       `json:"loan_period_days" gorm:"not null"`
+	RenewalLimit       int       `json:"renewal_limit" gorm:"not null"`
+	FineDailyRateCents int       `json:"fine_daily_rate_cents" gorm:"not null"`
+	FineGraceDays      int       `json:"fine_grace_days" gorm:"not null;default:0"`
+	FineMaxCents       int       `json:"fine_max_cents" gorm:"not null;default:0"` // 0 = no cap
+	CreatedAt          time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (MembershipCategory) TableName() string { return "membership_categories" }
+
+// IsValid reports whether the terms can be enforced: members must be able to
+// borrow at least one copy for at least one day, and no value may be negative.
+func (c *MembershipCategory) IsValid() bool {
+	return c.Name != "" && c.MaxLoans > 0 && c.LoanPeriodDays > 0 && c.RenewalLimit >= 0 &&
+		c.FineDailyRateCents >= 0 && c.FineGraceDays >= 0 && c.FineMaxCents >= 0
+}
@@ -8,6 +8,10 @@ import (
 
 // Reservation represents the reservations table
 // Schema: library.reservations
+// A reservation is active while CanceledAt, FulfilledLoanID and ExpiredAt are
+// all NULL; active reservations of a book form a FIFO queue ordered by ReservedAt.
+// Once a returned copy is set aside for it (HeldCopyID) the reservation is ready
+// for pickup until PickupDeadline, after which the sweeper expires it
 
 type Reservation struct {
 	ID              uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
@@ -19,5 +23,22 @@ type Reservation struct {
 	CanceledAt      *time.Time `json:"canceled_at"`                        // nullable
 	FulfilledLoanID *uuid.UUID `json:"fulfilled_loan_id" gorm:"type:uuid"` // nullable
 	FulfilledLoan   *Loan      `json:"fulfilled_loan,omitempty" gorm:"foreignKey:FulfilledLoanID;references:ID;constraint:OnDelete:SET NULL"`
+	HeldCopyID      *uuid.UUID `json:"held_copy_id" gorm:"type:uuid"` // nullable
+	HeldCopy        *BookCopy  `json:"held_copy,omitempty" gorm:"foreignKey:HeldCopyID;references:ID;constraint:OnDelete:SET NULL"`
+	ReadyAt         *time.Time `json:"ready_at"`        // nullable
+	PickupDeadline  *time.Time `json:"pickup_deadline"` // nullable
+	ExpiredAt       *time.Time `json:"expired_at"`      // nullable
 	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	Position        int        `json:"position,omitempty" gorm:"-"` // 1-based place in the queue, active only
+}
+
+// IsActive reports whether the reservation is still waiting in the queue.
+func (r *Reservation) IsActive() bool {
+	return r.CanceledAt == nil && r.FulfilledLoanID == nil && r.ExpiredAt == nil
+}
+
+// IsReadyForPickup reports whether a copy is waiting on the hold shelf for
+// this reservation.
+func (r *Reservation) IsReadyForPickup() bool {
+	return r.IsActive() && r.HeldCopyID != nil
 }
@@ -0,0 +1,45 @@
+package model
+
+import "github.com/google/uuid"
+
+// SearchResult is one page of the books matching a catalog search, best match
+// first. Total counts every match and the facets describe all of them, not
+// only the books on this page.
+type SearchResult struct {
+	Total  int64        `json:"total"`
+	Hits   []SearchHit  `json:"hits"`
+	Facets SearchFacets `json:"facets"`
+}
+
+// SearchHit is a matching book with its rank and the matched words of its
+// title, description and author names wrapped in <mark> tags.
+type SearchHit struct {
+	Book       Book             `json:"book"`
+	Rank       float64          `json:"rank"`
+	Highlights SearchHighlights `json:"highlights"`
+}
+
+type SearchHighlights struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Authors     string `json:"authors,omitempty"`
+}
+
+// SearchFacets counts the matches by publication year and by author, most
+// frequent first.
+type SearchFacets struct {
+	PublishedYears []YearFacet   `json:"published_years"`
+	Authors        []AuthorFacet `json:"authors"`
+}
+
+type YearFacet struct {
+	Year  int   `json:"year"`
+	Count int64 `json:"count"`
+}
+
+type AuthorFacet struct {
+	AuthorID  uuid.UUID `json:"author_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Count     int64     `json:"count"`
+}
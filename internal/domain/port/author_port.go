@@ -10,7 +10,16 @@ import (
 
 type AuthorPort interface {
 	Save(ctx context.Context, author *model.Author) error
-	FindAll(ctx context.Context) ([]model.Author, error)
+	FindAll(ctx context.Context, query ListQuery) (*Page[model.Author], error)
 	FindById(ctx context.Context, id uuid.UUID) (*model.Author, error)
+	// Update writes the non-zero fields of patch and returns the persisted
+	// author, or ErrAuthorNotFound when no live author has that id.
 	Update(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error)
+	// Patch writes the non-nil fields of patch, empty strings included.
+	Patch(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error)
+	// Delete soft-deletes an author. Unless force is set it fails with
+	// ErrAuthorHasBooks while the author is linked to any book; with force the
+	// links are kept but hidden until the author is restored.
+	Delete(ctx context.Context, id uuid.UUID, force bool) error
+	Restore(ctx context.Context, id uuid.UUID) (*model.Author, error)
 }
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type BookAuthorPort interface {
+	Link(ctx context.Context, link *model.BookAuthor) error
+	Unlink(ctx context.Context, bookID, authorID uuid.UUID) error
+	FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error)
+	FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error)
+}
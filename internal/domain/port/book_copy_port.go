@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type BookCopyPort interface {
+	Save(ctx context.Context, bookCopy *model.BookCopy) error
+	FindById(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	FindByBarcode(ctx context.Context, barcode string) (*model.BookCopy, error)
+	FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.BookCopy, error)
+	// Delete soft-deletes a copy. It fails with ErrCopyInCirculation while the
+	// copy is on loan or on the hold shelf.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Restore brings back a deleted copy; the copy of a deleted book can only
+	// come back with its book.
+	Restore(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+}
@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type BookPort interface {
+	Save(ctx context.Context, book *model.Book) error
+	FindAll(ctx context.Context, query ListQuery) (*Page[model.Book], error)
+	FindById(ctx context.Context, id uuid.UUID) (*model.Book, error)
+	Update(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error)
+	// Delete soft-deletes a book and its copies. It fails with
+	// ErrBookInCirculation while a copy is on loan or the book has active
+	// reservations.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Restore brings back a deleted book with the copies deleted along with it.
+	Restore(ctx context.Context, id uuid.UUID) (*model.Book, error)
+}
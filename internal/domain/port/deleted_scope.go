@@ -0,0 +1,17 @@
+package port
+
+import "context"
+
+type includeDeletedKey struct{}
+
+// WithDeleted marks ctx so that lookups of authors, books, copies and members
+// also return soft-deleted rows. Writes ignore the mark.
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// IncludesDeleted reports whether ctx was marked by WithDeleted.
+func IncludesDeleted(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type FineTransactionPort interface {
+	Save(ctx context.Context, transaction *model.FineTransaction) error
+	FindByLoan(ctx context.Context, loanID uuid.UUID) ([]model.FineTransaction, error)
+	FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.FineTransaction, error)
+}
@@ -0,0 +1,38 @@
+package port
+
+// DefaultListLimit and MaxListLimit bound the number of items in a page.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// ListQuery selects one page of a list. With Page set the list is paged by
+// offset; otherwise it is read by keyset from Cursor, or from the start when
+// Cursor is nil. Sort names a sortable field of the listed entity, empty for
+// its default order, and Filters holds the entity's filters by name.
+type ListQuery struct {
+	Limit   int
+	Page    int
+	Cursor  *Cursor
+	Sort    string
+	Desc    bool
+	Filters map[string]string
+}
+
+// Cursor is a keyset position: the sort value and the unique key of an item.
+// Before asks for the items that precede it rather than those that follow.
+type Cursor struct {
+	Value  string
+	Key    string
+	Before bool
+}
+
+// Page is one page of a list and Total the number of items matching the
+// filters. Next and Prev are nil when there is no page after or before this
+// one; otherwise they are the cursors that read it.
+type Page[T any] struct {
+	Items []T
+	Total int64
+	Next  *Cursor
+	Prev  *Cursor
+}
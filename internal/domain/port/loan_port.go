@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type LoanPort interface {
+	Save(ctx context.Context, loan *model.Loan) error
+	FindById(ctx context.Context, id uuid.UUID) (*model.Loan, error)
+	FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Loan, error)
+	FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error)
+	FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error)
+	CountActiveByMember(ctx context.Context, memberID uuid.UUID) (int, error)
+	CountOverdue(ctx context.Context, dueBefore time.Time) (int, error)
+	FindActiveByCopy(ctx context.Context, copyID uuid.UUID) (*model.Loan, error)
+	Close(ctx context.Context, loan *model.Loan) error
+	Renew(ctx context.Context, loan *model.Loan) error
+}
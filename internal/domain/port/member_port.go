@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type MemberPort interface {
+	Save(ctx context.Context, member *model.Member) error
+	FindAll(ctx context.Context, query ListQuery) (*Page[model.Member], error)
+	FindById(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	FindByEmail(ctx context.Context, email string) (*model.Member, error)
+	Update(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.Member, error)
+	// Delete soft-deletes a member. It fails with ErrMemberHasOpenLoans while
+	// the member has open loans or active reservations.
+	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) (*model.Member, error)
+}
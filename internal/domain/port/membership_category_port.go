@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"library/internal/domain/model"
+)
+
+type MembershipCategoryPort interface {
+	Save(ctx context.Context, category *model.MembershipCategory) error
+	FindAll(ctx context.Context, query ListQuery) (*Page[model.MembershipCategory], error)
+	FindByName(ctx context.Context, name string) (*model.MembershipCategory, error)
+	Update(ctx context.Context, name string, category *model.MembershipCategory) (*model.MembershipCategory, error)
+}
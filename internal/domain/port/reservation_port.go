@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type ReservationPort interface {
+	Save(ctx context.Context, reservation *model.Reservation) error
+	FindById(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	FindActiveByBook(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error)
+	FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Reservation, error)
+	Position(ctx context.Context, reservation *model.Reservation) (int, error)
+	ExistsActiveForBook(ctx context.Context, bookID, excludeMemberID uuid.UUID) (bool, error)
+	FindNextForBook(ctx context.Context, bookID uuid.UUID) (*model.Reservation, error)
+	FindReadyByCopy(ctx context.Context, copyID uuid.UUID) (*model.Reservation, error)
+	FindHoldShelf(ctx context.Context) ([]model.Reservation, error)
+	FindExpiredHolds(ctx context.Context, asOf time.Time, limit int) ([]model.Reservation, error)
+	MarkReady(ctx context.Context, reservation *model.Reservation) error
+	Cancel(ctx context.Context, reservation *model.Reservation) error
+	Fulfil(ctx context.Context, reservation *model.Reservation) error
+	Expire(ctx context.Context, reservation *model.Reservation) error
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+// SearchQuery is a catalog search. Text holds words that must all match, a
+// "quoted phrase" whose words must appear in order, or words ending in * that
+// match any word they start. Year and AuthorID narrow the matches to one facet.
+type SearchQuery struct {
+	Text     string
+	Year     *int
+	AuthorID *uuid.UUID
+	Limit    int
+	Page     int
+}
+
+type SearchPort interface {
+	// SearchBooks ranks the live books matching query by title, author names,
+	// description and ISBN, and counts all matches by year and by author.
+	SearchBooks(ctx context.Context, query SearchQuery) (*model.SearchResult, error)
+}
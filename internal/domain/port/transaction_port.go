@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// TransactionPort runs fn in a single database transaction. Repositories called
+// with the ctx handed to fn take part in that transaction; returning an error
+// from fn rolls it back.
+type TransactionPort interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -11,9 +12,12 @@ import (
 
 type AuthorServiceInterface interface {
 	CreateAuthor(ctx context.Context, author *model.Author) error
-	GetAuthors(ctx context.Context) ([]model.Author, error)
+	GetAuthors(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error)
 	GetAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error)
 	UpdateAuthor(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error)
+	PatchAuthor(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error)
+	DeleteAuthor(ctx context.Context, id uuid.UUID, force bool) error
+	RestoreAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error)
 }
 
 type AuthorService struct {
@@ -30,14 +34,42 @@ func (s *AuthorService) CreateAuthor(ctx context.Context, author *model.Author)
 	return s.repo.Save(ctx, author)
 }
 
-func (s *AuthorService) GetAuthors(ctx context.Context) ([]model.Author, error) {
-	return s.repo.FindAll(ctx)
+func (s *AuthorService) GetAuthors(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error) {
+	return s.repo.FindAll(ctx, query)
 }
 
 func (s *AuthorService) GetAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error) {
 	return s.repo.FindById(ctx, id)
 }
 
+// UpdateAuthor changes the fields set in patch. Empty fields keep their
+// current value, so a bio can only be cleared through PatchAuthor.
 func (s *AuthorService) UpdateAuthor(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error) {
 	return s.repo.Update(ctx, id, patch)
 }
+
+// PatchAuthor changes only the fields present in patch. The names may be
+// changed but not blanked.
+func (s *AuthorService) PatchAuthor(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error) {
+	for _, name := range []*string{patch.FirstName, patch.LastName} {
+		if name == nil {
+			continue
+		}
+		*name = strings.TrimSpace(*name)
+		if *name == "" {
+			return nil, model.ErrInvalidAuthorName
+		}
+	}
+	return s.repo.Patch(ctx, id, patch)
+}
+
+// DeleteAuthor soft-deletes an author that is not credited on any book. force
+// also hides the author from the byline of every book they are linked to,
+// until the author is restored.
+func (s *AuthorService) DeleteAuthor(ctx context.Context, id uuid.UUID, force bool) error {
+	return s.repo.Delete(ctx, id, force)
+}
+
+func (s *AuthorService) RestoreAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	return s.repo.Restore(ctx, id)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 	"library/internal/domain/service"
 	"library/internal/test/builder"
 	mmockAuthorRepo "library/internal/test/mock"
@@ -49,9 +50,7 @@ func TestGivenAnAuthorWhenUpdateInDBThenReturnUpdatedEntity(t *testing.T) {
 	updated := *existing
 	updated.Bio = patch.Bio
 
-	mockRepo.On("FindById", authorID).Return(existing, nil).Once()
-	mockRepo.On("Update", authorID, patch).Return(patch, nil).Once()
-	mockRepo.On("FindById", authorID).Return(&updated, nil).Once()
+	mockRepo.On("Update", authorID, patch).Return(&updated, nil).Once()
 
 	result, err := serviceAuthor.UpdateAuthor(t.Context(), authorID, patch)
 
@@ -66,10 +65,8 @@ func TestGivenUpdateErrorWhenUpdateInDBThenReturnError(t *testing.T) {
 	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
 	serviceAuthor := service.NewAuthorService(mockRepo)
 	patch := builder.UpdateAuthorBuilder().Build()
-	existing := builder.NewAuthorBuilder().Build()
 	errorExpected := errors.New("error updating into DB")
-	mockRepo.On("FindById", authorID).Return(existing, nil).Once()
-	mockRepo.On("Update", authorID, patch).Return(&model.Author{}, errorExpected).Once()
+	mockRepo.On("Update", authorID, patch).Return((*model.Author)(nil), errorExpected).Once()
 
 	result, err := serviceAuthor.UpdateAuthor(t.Context(), authorID, patch)
 
@@ -81,6 +78,7 @@ func TestGivenUpdateErrorWhenUpdateInDBThenReturnError(t *testing.T) {
 }
 
 func TestGivenAuthorsInDBWhenGetAuthorsThenReturnList(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
 	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
 	serviceAuthor := service.NewAuthorService(mockRepo)
 	firstAuthor := *builder.NewAuthorBuilder().Build()
@@ -89,23 +87,24 @@ func TestGivenAuthorsInDBWhenGetAuthorsThenReturnList(t *testing.T) {
 		firstAuthor,
 		secondAuthor,
 	}
-	mockRepo.On("FindAll").Return(expectedAuthors, nil)
+	mockRepo.On("FindAll", query).Return(&port.Page[model.Author]{Items: expectedAuthors}, nil)
 
-	result, err := serviceAuthor.GetAuthors(t.Context())
+	result, err := serviceAuthor.GetAuthors(t.Context(), query)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, expectedAuthors, result)
+	assert.Equal(t, expectedAuthors, result.Items)
 	mockRepo.AssertExpectations(t)
 }
 
 func TestGivenErrorWhenGetAuthorsThenReturnError(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
 	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
 	serviceAuthor := service.NewAuthorService(mockRepo)
 	expectedError := errors.New("error fetching authors")
-	mockRepo.On("FindAll").Return([]model.Author{}, expectedError)
+	mockRepo.On("FindAll", query).Return((*port.Page[model.Author])(nil), expectedError)
 
-	result, err := serviceAuthor.GetAuthors(t.Context())
+	result, err := serviceAuthor.GetAuthors(t.Context(), query)
 
 	assert.Empty(t, result)
 	assert.NotNil(t, err)
@@ -142,3 +141,68 @@ func TestGivenInvalidIDWhenGetAuthorThenReturnError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGivenLinkedAuthorWhenDeleteAuthorWithoutForceThenReturnHasBooks(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	serviceAuthor := service.NewAuthorService(mockRepo)
+	id := uuid.New()
+	mockRepo.On("Delete", id, false).Return(model.ErrAuthorHasBooks)
+
+	err := serviceAuthor.DeleteAuthor(t.Context(), id, false)
+
+	assert.ErrorIs(t, err, model.ErrAuthorHasBooks)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenForceWhenDeleteAuthorThenDelegateForceToRepository(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	serviceAuthor := service.NewAuthorService(mockRepo)
+	id := uuid.New()
+	mockRepo.On("Delete", id, true).Return(nil)
+
+	err := serviceAuthor.DeleteAuthor(t.Context(), id, true)
+
+	assert.Nil(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenUnknownAuthorWhenRestoreAuthorThenReturnNotFound(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	serviceAuthor := service.NewAuthorService(mockRepo)
+	id := uuid.New()
+	mockRepo.On("Restore", id).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+
+	result, err := serviceAuthor.RestoreAuthor(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBlankNameWhenPatchAuthorThenReturnInvalidName(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	serviceAuthor := service.NewAuthorService(mockRepo)
+	blank := "  "
+
+	result, err := serviceAuthor.PatchAuthor(t.Context(), uuid.New(), &model.AuthorPatch{LastName: &blank})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrInvalidAuthorName)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestGivenPatchWhenPatchAuthorThenTrimNamesAndDelegate(t *testing.T) {
+	mockRepo := new(mmockAuthorRepo.AuthorRepoMock)
+	serviceAuthor := service.NewAuthorService(mockRepo)
+	author := builder.NewAuthorBuilder().Build()
+	firstName, bio := " Ursula ", ""
+	patch := &model.AuthorPatch{FirstName: &firstName, Bio: &bio}
+	mockRepo.On("Patch", author.ID, patch).Return(author, nil)
+
+	result, err := serviceAuthor.PatchAuthor(t.Context(), author.ID, patch)
+
+	assert.Nil(t, err)
+	assert.Equal(t, author, result)
+	assert.Equal(t, "Ursula", *patch.FirstName)
+	mockRepo.AssertExpectations(t)
+}
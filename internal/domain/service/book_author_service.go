@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type BookAuthorServiceInterface interface {
+	LinkAuthor(ctx context.Context, link *model.BookAuthor) error
+	UnlinkAuthor(ctx context.Context, bookID, authorID uuid.UUID) error
+	GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error)
+	GetAuthorBooks(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error)
+}
+
+type BookAuthorService struct {
+	repo    port.BookAuthorPort
+	books   port.BookPort
+	authors port.AuthorPort
+}
+
+func NewBookAuthorService(repo port.BookAuthorPort, books port.BookPort, authors port.AuthorPort) *BookAuthorService {
+	return &BookAuthorService{repo: repo, books: books, authors: authors}
+}
+
+// LinkAuthor attaches an author to a book. An empty role defaults to author and
+// a zero position appends the author after the ones already linked.
+func (s *BookAuthorService) LinkAuthor(ctx context.Context, link *model.BookAuthor) error {
+	if link.Role == "" {
+		link.Role = model.BookAuthorRoleAuthor
+	}
+	if !model.IsValidBookAuthorRole(link.Role) {
+		return model.ErrInvalidBookAuthorRole
+	}
+	if _, err := s.authors.FindById(ctx, link.AuthorID); err != nil {
+		return err
+	}
+
+	current, err := s.GetBookAuthors(ctx, link.BookID)
+	if err != nil {
+		return err
+	}
+	if link.Position <= 0 {
+		link.Position = len(current) + 1
+	}
+	return s.repo.Link(ctx, link)
+}
+
+func (s *BookAuthorService) UnlinkAuthor(ctx context.Context, bookID, authorID uuid.UUID) error {
+	return s.repo.Unlink(ctx, bookID, authorID)
+}
+
+func (s *BookAuthorService) GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error) {
+	if _, err := s.books.FindById(ctx, bookID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBook(ctx, bookID)
+}
+
+func (s *BookAuthorService) GetAuthorBooks(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error) {
+	if _, err := s.authors.FindById(ctx, authorID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByAuthor(ctx, authorID)
+}
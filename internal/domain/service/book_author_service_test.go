@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type bookAuthorMocks struct {
+	links   *mockRepo.BookAuthorRepoMock
+	books   *mockRepo.BookRepoMock
+	authors *mockRepo.AuthorRepoMock
+}
+
+func newBookAuthorService() (*service.BookAuthorService, bookAuthorMocks) {
+	m := bookAuthorMocks{
+		links:   new(mockRepo.BookAuthorRepoMock),
+		books:   new(mockRepo.BookRepoMock),
+		authors: new(mockRepo.AuthorRepoMock),
+	}
+	return service.NewBookAuthorService(m.links, m.books, m.authors), m
+}
+
+func TestGivenLinkWithoutRoleAndPositionWhenLinkAuthorThenApplyDefaults(t *testing.T) {
+	svc, m := newBookAuthorService()
+	book := builder.NewBookBuilder().Build()
+	author := builder.NewAuthorBuilder().Build()
+	existing := []model.BookAuthor{*builder.NewBookAuthorBuilder().WithBookID(book.ID).Build()}
+	link := &model.BookAuthor{BookID: book.ID, AuthorID: author.ID}
+	m.authors.On("FindById", author.ID).Return(author, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.links.On("FindByBook", book.ID).Return(existing, nil)
+	m.links.On("Link", link).Return(nil)
+
+	err := svc.LinkAuthor(t.Context(), link)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.BookAuthorRoleAuthor, link.Role)
+	assert.Equal(t, 2, link.Position)
+	m.links.AssertExpectations(t)
+}
+
+func TestGivenUnknownRoleWhenLinkAuthorThenReturnInvalidRole(t *testing.T) {
+	svc, m := newBookAuthorService()
+	link := builder.NewBookAuthorBuilder().WithRole("narrator").Build()
+
+	err := svc.LinkAuthor(t.Context(), link)
+
+	assert.ErrorIs(t, err, model.ErrInvalidBookAuthorRole)
+	m.links.AssertNotCalled(t, "Link", mock.Anything)
+}
+
+func TestGivenUnknownAuthorWhenLinkAuthorThenReturnAuthorNotFound(t *testing.T) {
+	svc, m := newBookAuthorService()
+	link := builder.NewBookAuthorBuilder().WithRole(model.BookAuthorRoleTranslator).Build()
+	m.authors.On("FindById", link.AuthorID).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+
+	err := svc.LinkAuthor(t.Context(), link)
+
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
+	m.links.AssertNotCalled(t, "Link", mock.Anything)
+}
+
+func TestGivenUnknownBookWhenGetBookAuthorsThenReturnBookNotFound(t *testing.T) {
+	svc, m := newBookAuthorService()
+	id := uuid.New()
+	m.books.On("FindById", id).Return((*model.Book)(nil), model.ErrBookNotFound)
+
+	result, err := svc.GetBookAuthors(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	m.links.AssertNotCalled(t, "FindByBook", mock.Anything)
+}
+
+func TestGivenAuthorWithBooksWhenGetAuthorBooksThenReturnLinks(t *testing.T) {
+	svc, m := newBookAuthorService()
+	author := builder.NewAuthorBuilder().Build()
+	expected := []model.BookAuthor{*builder.NewBookAuthorBuilder().WithAuthorID(author.ID).Build()}
+	m.authors.On("FindById", author.ID).Return(author, nil)
+	m.links.On("FindByAuthor", author.ID).Return(expected, nil)
+
+	result, err := svc.GetAuthorBooks(t.Context(), author.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+	m.links.AssertExpectations(t)
+}
+
+func TestGivenMissingLinkWhenUnlinkAuthorThenReturnNotLinked(t *testing.T) {
+	svc, m := newBookAuthorService()
+	bookID, authorID := uuid.New(), uuid.New()
+	m.links.On("Unlink", bookID, authorID).Return(model.ErrBookAuthorNotLinked)
+
+	err := svc.UnlinkAuthor(t.Context(), bookID, authorID)
+
+	assert.ErrorIs(t, err, model.ErrBookAuthorNotLinked)
+	m.links.AssertExpectations(t)
+}
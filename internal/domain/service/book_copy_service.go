@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type BookCopyServiceInterface interface {
+	RegisterCopy(ctx context.Context, bookCopy *model.BookCopy) error
+	GetCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	GetCopyByBarcode(ctx context.Context, barcode string) (*model.BookCopy, error)
+	GetBookCopies(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error)
+	RetireCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	ReactivateCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+	DeleteCopy(ctx context.Context, id uuid.UUID) error
+	RestoreCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error)
+}
+
+type BookCopyService struct {
+	tx           port.TransactionPort
+	repo         port.BookCopyPort
+	books        port.BookPort
+	reservations port.ReservationPort
+}
+
+func NewBookCopyService(
+	tx port.TransactionPort,
+	repo port.BookCopyPort,
+	books port.BookPort,
+	reservations port.ReservationPort,
+) *BookCopyService {
+	return &BookCopyService{tx: tx, repo: repo, books: books, reservations: reservations}
+}
+
+func (s *BookCopyService) RegisterCopy(ctx context.Context, bookCopy *model.BookCopy) error {
+	if _, err := s.books.FindById(ctx, bookCopy.BookID); err != nil {
+		return err
+	}
+	bookCopy.ID = uuid.New()
+	bookCopy.IsActive = true
+	return s.repo.Save(ctx, bookCopy)
+}
+
+func (s *BookCopyService) GetCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+func (s *BookCopyService) GetCopyByBarcode(ctx context.Context, barcode string) (*model.BookCopy, error) {
+	return s.repo.FindByBarcode(ctx, barcode)
+}
+
+func (s *BookCopyService) GetBookCopies(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error) {
+	if _, err := s.books.FindById(ctx, bookID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBook(ctx, bookID)
+}
+
+// RetireCopy takes a copy out of circulation. A copy that is currently lent
+// cannot be retired until it is returned, nor one waiting on the hold shelf
+// until its hold is cancelled. The copy row stays locked until it is retired,
+// so no checkout can start in between.
+func (s *BookCopyService) RetireCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	var retired *model.BookCopy
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		bookCopy, err := s.repo.FindByIdForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !bookCopy.IsActive {
+			retired = bookCopy
+			return nil
+		}
+		if !bookCopy.Available {
+			return s.circulationError(ctx, id)
+		}
+		retired, err = s.repo.SetActive(ctx, id, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retired, nil
+}
+
+// circulationError tells a copy on the hold shelf from one on loan.
+func (s *BookCopyService) circulationError(ctx context.Context, id uuid.UUID) error {
+	_, err := s.reservations.FindReadyByCopy(ctx, id)
+	switch {
+	case err == nil:
+		return model.ErrCopyHeldForPickup
+	case errors.Is(err, model.ErrReservationNotFound):
+		return model.ErrCopyAlreadyOnLoan
+	default:
+		return err
+	}
+}
+
+func (s *BookCopyService) ReactivateCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return s.repo.SetActive(ctx, id, true)
+}
+
+// DeleteCopy soft-deletes a copy that is neither on loan nor on the hold shelf.
+// Unlike retiring, a deleted copy is hidden from the catalogue.
+func (s *BookCopyService) DeleteCopy(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *BookCopyService) RestoreCopy(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return s.repo.Restore(ctx, id)
+}
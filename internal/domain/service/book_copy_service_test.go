@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenExistingBookWhenRegisterCopyThenSaveActiveCopy(t *testing.T) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil)
+	book := builder.NewBookBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithBookID(book.ID).WithActive(false).Build()
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("Save", bookCopy).Return(nil)
+
+	err := svc.RegisterCopy(t.Context(), bookCopy)
+
+	assert.Nil(t, err)
+	assert.True(t, bookCopy.IsActive)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenUnknownBookWhenRegisterCopyThenReturnBookNotFound(t *testing.T) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	books.On("FindById", bookCopy.BookID).Return((*model.Book)(nil), model.ErrBookNotFound)
+
+	err := svc.RegisterCopy(t.Context(), bookCopy)
+
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	copies.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenBarcodeWhenGetCopyByBarcodeThenReturnCopy(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	bookCopy := builder.NewBookCopyBuilder().WithBarcode("LIB-0001").Build()
+	copies.On("FindByBarcode", "LIB-0001").Return(bookCopy, nil)
+
+	result, err := svc.GetCopyByBarcode(t.Context(), "LIB-0001")
+
+	assert.Nil(t, err)
+	assert.Equal(t, bookCopy, result)
+}
+
+func TestGivenBookWhenGetBookCopiesThenReturnCopies(t *testing.T) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil)
+	book := builder.NewBookBuilder().Build()
+	expected := []model.BookCopy{*builder.NewBookCopyBuilder().WithBookID(book.ID).WithAvailable(false).Build()}
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("FindByBook", book.ID).Return(expected, nil)
+
+	result, err := svc.GetBookCopies(t.Context(), book.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestGivenAvailableCopyWhenRetireCopyThenDeactivate(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	retired := builder.NewBookCopyBuilder().WithID(bookCopy.ID).WithActive(false).WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	copies.On("SetActive", bookCopy.ID, false).Return(retired, nil)
+
+	result, err := svc.RetireCopy(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, err)
+	assert.False(t, result.IsActive)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyOnLoanWhenRetireCopyThenReturnOnLoan(t *testing.T) {
+	copies, reservations := new(mockRepo.BookCopyRepoMock), new(mockRepo.ReservationRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, reservations)
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	reservations.On("FindReadyByCopy", bookCopy.ID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+
+	result, err := svc.RetireCopy(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyAlreadyOnLoan)
+	copies.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything)
+}
+
+func TestGivenCopyOnHoldShelfWhenRetireCopyThenReturnHeldForPickup(t *testing.T) {
+	copies, reservations := new(mockRepo.BookCopyRepoMock), new(mockRepo.ReservationRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, reservations)
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	reservations.On("FindReadyByCopy", bookCopy.ID).Return(&model.Reservation{HeldCopyID: &bookCopy.ID}, nil)
+
+	result, err := svc.RetireCopy(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyHeldForPickup)
+	copies.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything)
+}
+
+func TestGivenRetiredCopyWhenRetireCopyThenReturnItUnchanged(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	bookCopy := builder.NewBookCopyBuilder().WithActive(false).WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+
+	result, err := svc.RetireCopy(t.Context(), bookCopy.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, bookCopy, result)
+	copies.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything)
+}
+
+func TestGivenUnknownCopyWhenReactivateCopyThenReturnNotFound(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	id := uuid.New()
+	copies.On("SetActive", id, true).Return((*model.BookCopy)(nil), model.ErrCopyNotFound)
+
+	result, err := svc.ReactivateCopy(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyNotFound)
+}
+
+func TestGivenCopyOnLoanWhenDeleteCopyThenReturnInCirculation(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	id := uuid.New()
+	copies.On("Delete", id).Return(model.ErrCopyInCirculation)
+
+	err := svc.DeleteCopy(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrCopyInCirculation)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyOfDeletedBookWhenRestoreCopyThenReturnBookNotFound(t *testing.T) {
+	copies := new(mockRepo.BookCopyRepoMock)
+	svc := service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, nil)
+	id := uuid.New()
+	copies.On("Restore", id).Return((*model.BookCopy)(nil), model.ErrBookNotFound)
+
+	result, err := svc.RestoreCopy(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+}
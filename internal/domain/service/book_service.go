@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type BookServiceInterface interface {
+	CreateBook(ctx context.Context, book *model.Book) error
+	GetBooks(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error)
+	GetBook(ctx context.Context, id uuid.UUID) (*model.Book, error)
+	UpdateBook(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error)
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+	RestoreBook(ctx context.Context, id uuid.UUID) (*model.Book, error)
+}
+
+type BookService struct {
+	repo port.BookPort
+}
+
+func NewBookService(repo port.BookPort) *BookService {
+	return &BookService{repo}
+}
+
+func (s *BookService) CreateBook(ctx context.Context, book *model.Book) error {
+	book.ID = uuid.New()
+	return s.repo.Save(ctx, book)
+}
+
+func (s *BookService) GetBooks(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error) {
+	return s.repo.FindAll(ctx, query)
+}
+
+func (s *BookService) GetBook(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+func (s *BookService) UpdateBook(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error) {
+	return s.repo.Update(ctx, id, patch)
+}
+
+// DeleteBook soft-deletes a book together with its copies. Books with copies
+// on loan or with active reservations cannot be deleted.
+func (s *BookService) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *BookService) RestoreBook(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	return s.repo.Restore(ctx, id)
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockBookRepo "library/internal/test/mock"
+)
+
+func TestGivenABookWhenCreateBookThenAssignIDAndSave(t *testing.T) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	book := builder.NewBookBuilder().WithID(uuid.Nil).Build()
+	mockRepo.On("Save", mock.AnythingOfType("*model.Book")).Return(nil)
+
+	err := serviceBook.CreateBook(t.Context(), book)
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, book.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenDuplicatedISBNWhenCreateBookThenReturnConflict(t *testing.T) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Save", mock.Anything).Return(model.ErrBookISBNConflict)
+
+	err := serviceBook.CreateBook(t.Context(), book)
+
+	assert.ErrorIs(t, err, model.ErrBookISBNConflict)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBooksInDBWhenGetBooksThenReturnList(t *testing.T) {
+	query := port.ListQuery{Limit: port.DefaultListLimit}
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	expectedBooks := []model.Book{*builder.NewBookBuilder().Build(), *builder.NewBookBuilder().Build()}
+	mockRepo.On("FindAll", query).Return(&port.Page[model.Book]{Items: expectedBooks}, nil)
+
+	result, err := serviceBook.GetBooks(t.Context(), query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBooks, result.Items)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenValidIDWhenGetBookThenReturnBook(t *testing.T) {
+	expectedBook := builder.NewBookBuilder().Build()
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	mockRepo.On("FindById", expectedBook.ID).Return(expectedBook, nil)
+
+	result, err := serviceBook.GetBook(t.Context(), expectedBook.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBook, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenABookWhenUpdateBookThenReturnUpdatedEntity(t *testing.T) {
+	existing := builder.NewBookBuilder().Build()
+	patch := builder.NewBookBuilder().WithTitle("Another title").Build()
+	updated := *existing
+	updated.Title = patch.Title
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	mockRepo.On("Update", existing.ID, patch).Return(&updated, nil)
+
+	result, err := serviceBook.UpdateBook(t.Context(), existing.ID, patch)
+
+	assert.Nil(t, err)
+	assert.Equal(t, patch.Title, result.Title)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenUnknownBookWhenDeleteBookThenReturnNotFound(t *testing.T) {
+	id := uuid.New()
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	mockRepo.On("Delete", id).Return(model.ErrBookNotFound)
+
+	err := serviceBook.DeleteBook(t.Context(), id)
+
+	assert.True(t, errors.Is(err, model.ErrBookNotFound))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBookInCirculationWhenDeleteBookThenReturnInCirculation(t *testing.T) {
+	id := uuid.New()
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	mockRepo.On("Delete", id).Return(model.ErrBookInCirculation)
+
+	err := serviceBook.DeleteBook(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrBookInCirculation)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenDeletedBookWhenRestoreBookThenReturnRestoredBook(t *testing.T) {
+	book := builder.NewBookBuilder().Build()
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	serviceBook := service.NewBookService(mockRepo)
+	mockRepo.On("Restore", book.ID).Return(book, nil)
+
+	result, err := serviceBook.RestoreBook(t.Context(), book.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, book, result)
+	mockRepo.AssertExpectations(t)
+}
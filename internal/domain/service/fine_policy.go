@@ -0,0 +1,48 @@
+package service
+
+import (
+	"time"
+
+	"library/internal/domain/model"
+)
+
+// FinePolicy computes the overdue fine of a loan as of a given instant.
+type FinePolicy interface {
+	Fine(loan *model.Loan, member *model.Member, asOf time.Time) int
+}
+
+// CategoryFinePolicy charges the flat per-day fine of the member's membership
+// category. Days inside the grace period are never charged and the category's
+// maximum caps the total; a zero maximum means no cap. The member must be read
+// with its category.
+type CategoryFinePolicy struct{}
+
+func (CategoryFinePolicy) Fine(loan *model.Loan, member *model.Member, asOf time.Time) int {
+	category := member.MembershipCategory
+
+	end := asOf
+	if loan.ReturnedAt != nil {
+		end = *loan.ReturnedAt
+	}
+	chargeable := OverdueDays(loan.DueDate, end) - category.FineGraceDays
+	if chargeable <= 0 {
+		return 0
+	}
+
+	fine := chargeable * category.FineDailyRateCents
+	if category.FineMaxCents > 0 && fine > category.FineMaxCents {
+		return category.FineMaxCents
+	}
+	return fine
+}
+
+// OverdueDays counts the calendar days between the due date and end. A copy
+// returned any time on its due date is not overdue.
+func OverdueDays(dueDate, end time.Time) int {
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	if !day.After(due) {
+		return 0
+	}
+	return int(day.Sub(due).Hours() / 24)
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+)
+
+var fineDueDate = time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+// fineMember belongs to a category charging 50 a day after 2 days of grace,
+// up to 1000.
+func fineMember() *model.Member {
+	category := builder.NewMembershipCategoryBuilder().WithFine(50, 2, 1000).Build()
+	return builder.NewMemberBuilder().WithMembershipCategory(category).Build()
+}
+
+func TestGivenLoanReturnedOnDueDateWhenFineThenReturnZero(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).WithReturnedAt(fineDueDate.Add(20 * time.Hour)).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, fineMember(), time.Now())
+
+	assert.Equal(t, 0, fine)
+}
+
+func TestGivenLoanInsideGracePeriodWhenFineThenReturnZero(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, fineMember(), fineDueDate.AddDate(0, 0, 2))
+
+	assert.Equal(t, 0, fine)
+}
+
+func TestGivenOpenLoanPastGracePeriodWhenFineThenChargeDaysBeyondGrace(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, fineMember(), fineDueDate.AddDate(0, 0, 5))
+
+	assert.Equal(t, 150, fine)
+}
+
+func TestGivenReturnedLoanWhenFineThenStopAtReturnDate(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).WithReturnedAt(fineDueDate.AddDate(0, 0, 4)).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, fineMember(), fineDueDate.AddDate(0, 0, 30))
+
+	assert.Equal(t, 100, fine)
+}
+
+func TestGivenLongOverdueLoanWhenFineThenApplyCap(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, fineMember(), fineDueDate.AddDate(0, 0, 90))
+
+	assert.Equal(t, 1000, fine)
+}
+
+func TestGivenMemberWithLoadedCategoryWhenFineThenApplyCategoryTerms(t *testing.T) {
+	loan := builder.NewLoanBuilder().WithDueDate(fineDueDate).Build()
+	category := builder.NewMembershipCategoryBuilder().WithFine(30, 1, 60).Build()
+	member := builder.NewMemberBuilder().WithMembershipCategory(category).Build()
+
+	fine := service.CategoryFinePolicy{}.Fine(loan, member, fineDueDate.AddDate(0, 0, 2))
+
+	assert.Equal(t, 30, fine)
+	assert.Equal(t, 60, service.CategoryFinePolicy{}.Fine(loan, member, fineDueDate.AddDate(0, 0, 10)))
+}
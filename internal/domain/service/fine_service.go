@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type FineServiceInterface interface {
+	MemberFines(ctx context.Context, memberID uuid.UUID) (*model.FineSummary, error)
+	LoanFine(ctx context.Context, loanID uuid.UUID) (*model.LoanFine, error)
+	RecordTransaction(ctx context.Context, loanID uuid.UUID, transaction *model.FineTransaction) (*model.FineTransaction, error)
+}
+
+type FineService struct {
+	tx           port.TransactionPort
+	loans        port.LoanPort
+	members      port.MemberPort
+	transactions port.FineTransactionPort
+	policy       FinePolicy
+	now          func() time.Time
+}
+
+func NewFineService(
+	tx port.TransactionPort,
+	loans port.LoanPort,
+	members port.MemberPort,
+	transactions port.FineTransactionPort,
+	policy FinePolicy,
+) *FineService {
+	return &FineService{
+		tx:           tx,
+		loans:        loans,
+		members:      members,
+		transactions: transactions,
+		policy:       policy,
+		now:          time.Now,
+	}
+}
+
+// MemberFines lists every loan of the member that carries a fine, evaluating
+// open loans against the policy as of now.
+func (s *FineService) MemberFines(ctx context.Context, memberID uuid.UUID) (*model.FineSummary, error) {
+	member, err := s.members.FindById(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	loans, err := s.loans.FindByMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := s.transactions.FindByMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	byLoan := make(map[uuid.UUID][]model.FineTransaction)
+	for _, t := range transactions {
+		byLoan[t.LoanID] = append(byLoan[t.LoanID], t)
+	}
+
+	summary := &model.FineSummary{MemberID: memberID, Loans: []model.LoanFine{}}
+	now := s.now()
+	for i := range loans {
+		fine := s.evaluate(&loans[i], member, byLoan[loans[i].ID], now)
+		if fine.FineCents == 0 && fine.PaidCents == 0 && fine.WaivedCents == 0 {
+			continue
+		}
+		summary.FineCents += fine.FineCents
+		summary.PaidCents += fine.PaidCents
+		summary.WaivedCents += fine.WaivedCents
+		summary.OutstandingCents += fine.OutstandingCents
+		summary.Loans = append(summary.Loans, fine)
+	}
+	return summary, nil
+}
+
+// LoanFine evaluates the fine of a single loan as of now.
+func (s *FineService) LoanFine(ctx context.Context, loanID uuid.UUID) (*model.LoanFine, error) {
+	loan, err := s.loans.FindById(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	return s.loanPosition(ctx, loan)
+}
+
+// RecordTransaction settles part of a loan fine with a payment or a waiver.
+// The amount may not exceed what is still outstanding; the loan row stays
+// locked so concurrent payments cannot settle the same balance twice.
+func (s *FineService) RecordTransaction(
+	ctx context.Context,
+	loanID uuid.UUID,
+	transaction *model.FineTransaction,
+) (*model.FineTransaction, error) {
+	if !model.IsValidFineTransactionKind(transaction.Kind) {
+		return nil, model.ErrInvalidFineTransactionKind
+	}
+	if transaction.AmountCents <= 0 {
+		return nil, model.ErrInvalidFineAmount
+	}
+
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		loan, err := s.loans.FindByIdForUpdate(ctx, loanID)
+		if err != nil {
+			return err
+		}
+		fine, err := s.loanPosition(ctx, loan)
+		if err != nil {
+			return err
+		}
+		if transaction.AmountCents > fine.OutstandingCents {
+			return model.ErrFineAmountExceedsBalance
+		}
+
+		transaction.ID = uuid.New()
+		transaction.LoanID = loan.ID
+		transaction.MemberID = loan.MemberID
+		return s.transactions.Save(ctx, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (s *FineService) loanPosition(ctx context.Context, loan *model.Loan) (*model.LoanFine, error) {
+	member, err := s.members.FindById(ctx, loan.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := s.transactions.FindByLoan(ctx, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	fine := s.evaluate(loan, member, transactions, s.now())
+	return &fine, nil
+}
+
+// evaluate uses the fine stored on return for closed loans and the policy for
+// loans that are still open.
+func (s *FineService) evaluate(
+	loan *model.Loan,
+	member *model.Member,
+	transactions []model.FineTransaction,
+	now time.Time,
+) model.LoanFine {
+	fine := model.LoanFine{
+		LoanID:     loan.ID,
+		CopyID:     loan.CopyID,
+		DueDate:    loan.DueDate,
+		ReturnedAt: loan.ReturnedAt,
+		Accruing:   loan.ReturnedAt == nil,
+		FineCents:  loan.FineCents,
+	}
+	if fine.Accruing {
+		fine.FineCents = s.policy.Fine(loan, member, now)
+	}
+
+	for _, t := range transactions {
+		switch t.Kind {
+		case model.FineTransactionPayment:
+			fine.PaidCents += t.AmountCents
+		case model.FineTransactionWaiver:
+			fine.WaivedCents += t.AmountCents
+		}
+	}
+	fine.OutstandingCents = max(fine.FineCents-fine.PaidCents-fine.WaivedCents, 0)
+	return fine
+}
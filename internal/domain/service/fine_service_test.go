@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type fineMocks struct {
+	loans        *mockRepo.LoanRepoMock
+	members      *mockRepo.MemberRepoMock
+	transactions *mockRepo.FineTransactionRepoMock
+}
+
+func newFineService() (*service.FineService, fineMocks) {
+	m := fineMocks{
+		loans:        new(mockRepo.LoanRepoMock),
+		members:      new(mockRepo.MemberRepoMock),
+		transactions: new(mockRepo.FineTransactionRepoMock),
+	}
+	policy := service.CategoryFinePolicy{}
+	return service.NewFineService(mockRepo.TransactionMock{}, m.loans, m.members, m.transactions, policy), m
+}
+
+func TestGivenReturnedAndOpenOverdueLoansWhenMemberFinesThenAggregateBalances(t *testing.T) {
+	svc, m := newFineService()
+	member := builder.NewMemberBuilder().Build()
+	returned := builder.NewLoanBuilder().WithMemberID(member.ID).
+		WithReturnedAt(time.Now()).WithFineCents(300).Build()
+	open := builder.NewLoanBuilder().WithMemberID(member.ID).
+		WithDueDate(time.Now().AddDate(0, 0, -2)).Build()
+	onTime := builder.NewLoanBuilder().WithMemberID(member.ID).Build()
+	payment := builder.NewFineTransactionBuilder().WithLoanID(returned.ID).WithAmountCents(100).Build()
+	waiver := builder.NewFineTransactionBuilder().WithLoanID(returned.ID).
+		WithKind(model.FineTransactionWaiver).WithAmountCents(50).Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("FindByMember", member.ID).Return([]model.Loan{*returned, *open, *onTime}, nil)
+	m.transactions.On("FindByMember", member.ID).Return([]model.FineTransaction{*payment, *waiver}, nil)
+
+	summary, err := svc.MemberFines(t.Context(), member.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, summary.Loans, 2)
+	assert.Equal(t, 350, summary.FineCents)
+	assert.Equal(t, 100, summary.PaidCents)
+	assert.Equal(t, 50, summary.WaivedCents)
+	assert.Equal(t, 200, summary.OutstandingCents)
+	assert.False(t, summary.Loans[0].Accruing)
+	assert.True(t, summary.Loans[1].Accruing)
+}
+
+func TestGivenUnknownMemberWhenMemberFinesThenReturnNotFound(t *testing.T) {
+	svc, m := newFineService()
+	id := uuid.New()
+	m.members.On("FindById", id).Return((*model.Member)(nil), model.ErrMemberNotFound)
+
+	summary, err := svc.MemberFines(t.Context(), id)
+
+	assert.Nil(t, summary)
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+}
+
+func TestGivenOpenOverdueLoanWhenLoanFineThenEvaluateOnDemand(t *testing.T) {
+	svc, m := newFineService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithDueDate(time.Now().AddDate(0, 0, -3)).Build()
+	m.loans.On("FindById", loan.ID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+
+	fine, err := svc.LoanFine(t.Context(), loan.ID)
+
+	assert.Nil(t, err)
+	assert.True(t, fine.Accruing)
+	assert.Equal(t, 75, fine.FineCents)
+	assert.Equal(t, 75, fine.OutstandingCents)
+}
+
+func TestGivenOutstandingFineWhenRecordPaymentThenSaveTransaction(t *testing.T) {
+	svc, m := newFineService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(200).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+	m.transactions.On("Save", mock.AnythingOfType("*model.FineTransaction")).Return(nil)
+
+	transaction, err := svc.RecordTransaction(t.Context(), loan.ID,
+		&model.FineTransaction{Kind: model.FineTransactionPayment, AmountCents: 200})
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, transaction.ID)
+	assert.Equal(t, loan.ID, transaction.LoanID)
+	assert.Equal(t, member.ID, transaction.MemberID)
+	m.transactions.AssertExpectations(t)
+}
+
+func TestGivenAmountAboveBalanceWhenRecordTransactionThenReturnExceedsBalance(t *testing.T) {
+	svc, m := newFineService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(200).Build()
+	paid := builder.NewFineTransactionBuilder().WithLoanID(loan.ID).WithAmountCents(150).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{*paid}, nil)
+
+	transaction, err := svc.RecordTransaction(t.Context(), loan.ID,
+		&model.FineTransaction{Kind: model.FineTransactionWaiver, AmountCents: 60})
+
+	assert.Nil(t, transaction)
+	assert.ErrorIs(t, err, model.ErrFineAmountExceedsBalance)
+	m.transactions.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenInvalidKindWhenRecordTransactionThenReturnInvalidKind(t *testing.T) {
+	svc, m := newFineService()
+
+	transaction, err := svc.RecordTransaction(t.Context(), uuid.New(),
+		&model.FineTransaction{Kind: "refund", AmountCents: 10})
+
+	assert.Nil(t, transaction)
+	assert.ErrorIs(t, err, model.ErrInvalidFineTransactionKind)
+	m.loans.AssertNotCalled(t, "FindByIdForUpdate", mock.Anything)
+}
+
+func TestGivenNonPositiveAmountWhenRecordTransactionThenReturnInvalidAmount(t *testing.T) {
+	svc, _ := newFineService()
+
+	transaction, err := svc.RecordTransaction(t.Context(), uuid.New(),
+		&model.FineTransaction{Kind: model.FineTransactionPayment, AmountCents: -5})
+
+	assert.Nil(t, transaction)
+	assert.ErrorIs(t, err, model.ErrInvalidFineAmount)
+}
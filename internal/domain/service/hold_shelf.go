@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// DefaultHoldPickupDays is how long a copy waits on the hold shelf.
+const DefaultHoldPickupDays = 3
+
+// shelveForNextHold sets a copy aside for the next member waiting for its
+// book, who then has pickupDays to check it out. Retired copies and books
+// without a queue are left alone.
+func shelveForNextHold(
+	ctx context.Context,
+	reservations port.ReservationPort,
+	bookCopy *model.BookCopy,
+	now time.Time,
+	pickupDays int,
+) error {
+	if !bookCopy.IsActive {
+		return nil
+	}
+
+	reservation, err := reservations.FindNextForBook(ctx, bookCopy.BookID)
+	if errors.Is(err, model.ErrReservationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deadline := now.AddDate(0, 0, pickupDays)
+	reservation.HeldCopyID = &bookCopy.ID
+	reservation.ReadyAt = &now
+	reservation.PickupDeadline = &deadline
+	return reservations.MarkReady(ctx, reservation)
+}
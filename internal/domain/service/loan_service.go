@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// LoanPolicy sets the circulation rules shared by every member. A loan
+// overdue by more than RenewalMaxOverdueDays can no longer be renewed.
+// HoldPickupDays is how long a returned copy waits on the hold shelf for the
+// next member in the queue. The loan limit, period and renewals come from the
+// member's membership category.
+type LoanPolicy struct {
+	RenewalMaxOverdueDays int
+	HoldPickupDays        int
+}
+
+func DefaultLoanPolicy() LoanPolicy {
+	return LoanPolicy{HoldPickupDays: DefaultHoldPickupDays}
+}
+
+type LoanServiceInterface interface {
+	Checkout(ctx context.Context, memberID, copyID uuid.UUID, dueDate *time.Time) (*model.Loan, error)
+	Return(ctx context.Context, copyID uuid.UUID) (*model.Loan, error)
+	Renew(ctx context.Context, loanID uuid.UUID) (*model.Loan, error)
+	CountOverdue(ctx context.Context) (int, error)
+}
+
+type LoanService struct {
+	tx           port.TransactionPort
+	loans        port.LoanPort
+	copies       port.BookCopyPort
+	members      port.MemberPort
+	reservations port.ReservationPort
+	fines        FinePolicy
+	policy       LoanPolicy
+	now          func() time.Time
+}
+
+func NewLoanService(
+	tx port.TransactionPort,
+	loans port.LoanPort,
+	copies port.BookCopyPort,
+	members port.MemberPort,
+	reservations port.ReservationPort,
+	fines FinePolicy,
+	policy LoanPolicy,
+) *LoanService {
+	return &LoanService{
+		tx:           tx,
+		loans:        loans,
+		copies:       copies,
+		members:      members,
+		reservations: reservations,
+		fines:        fines,
+		policy:       policy,
+		now:          time.Now,
+	}
+}
+
+// Checkout lends a copy to a member. Both must be active, the member must be
+// under the loan limit of their category and the copy must not have an open
+// loan; the member and copy rows stay locked until the loan is stored. A copy
+// on the hold shelf can only be checked out by the member it is held for,
+// which fulfils their reservation. A nil dueDate gives the loan period of the
+// member's category; an explicit one must be in the future.
+func (s *LoanService) Checkout(
+	ctx context.Context,
+	memberID, copyID uuid.UUID,
+	dueDate *time.Time,
+) (*model.Loan, error) {
+	if dueDate != nil && !dueDate.After(s.now()) {
+		return nil, model.ErrInvalidDueDate
+	}
+
+	var loan *model.Loan
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		member, err := s.members.FindByIdForUpdate(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if !member.IsActive {
+			return model.ErrMemberInactive
+		}
+		category := member.MembershipCategory
+		open, err := s.loans.CountActiveByMember(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if open >= category.MaxLoans {
+			return model.ErrLoanLimitReached
+		}
+
+		bookCopy, err := s.copies.FindByIdForUpdate(ctx, copyID)
+		if err != nil {
+			return err
+		}
+		if !bookCopy.IsActive {
+			return model.ErrCopyInactive
+		}
+		var hold *model.Reservation
+		if !bookCopy.Available {
+			if hold, err = s.heldFor(ctx, copyID, memberID); err != nil {
+				return err
+			}
+		}
+
+		now := s.now()
+		loan = &model.Loan{
+			ID:       uuid.New(),
+			MemberID: memberID,
+			CopyID:   copyID,
+			LoanedAt: now,
+			DueDate:  now.AddDate(0, 0, category.LoanPeriodDays),
+		}
+		if dueDate != nil {
+			loan.DueDate = *dueDate
+		}
+		if err := s.loans.Save(ctx, loan); err != nil {
+			return err
+		}
+		if hold == nil {
+			return nil
+		}
+		hold.FulfilledLoanID = &loan.ID
+		return s.reservations.Fulfil(ctx, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+// Return closes the open loan of a copy, setting returned_at to now and
+// charging the overdue fine of the member's category. When the book has a
+// hold queue the copy is set aside for the member at its head, in the same
+// transaction.
+func (s *LoanService) Return(ctx context.Context, copyID uuid.UUID) (*model.Loan, error) {
+	var loan *model.Loan
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		loan, err = s.loans.FindActiveByCopy(ctx, copyID)
+		if err != nil {
+			return err
+		}
+
+		member, err := s.members.FindById(ctx, loan.MemberID)
+		if err != nil {
+			return err
+		}
+
+		returnedAt := s.now()
+		loan.ReturnedAt = &returnedAt
+		loan.FineCents = s.fines.Fine(loan, member, returnedAt)
+		if err := s.loans.Close(ctx, loan); err != nil {
+			return err
+		}
+
+		bookCopy, err := s.copies.FindById(ctx, copyID)
+		if err != nil {
+			return err
+		}
+		return shelveForNextHold(ctx, s.reservations, bookCopy, returnedAt, s.policy.HoldPickupDays)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+// Renew extends the due date of an open loan by the loan period of the
+// member's category. It is refused once the renewal limit of the category is
+// reached, when the loan is too overdue, or when another member is waiting
+// for the same book.
+func (s *LoanService) Renew(ctx context.Context, loanID uuid.UUID) (*model.Loan, error) {
+	var loan *model.Loan
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		loan, err = s.loans.FindByIdForUpdate(ctx, loanID)
+		if err != nil {
+			return err
+		}
+		if loan.ReturnedAt != nil {
+			return model.ErrLoanAlreadyReturned
+		}
+		member, err := s.members.FindById(ctx, loan.MemberID)
+		if err != nil {
+			return err
+		}
+		category := member.MembershipCategory
+		if loan.RenewalCount >= category.RenewalLimit {
+			return model.ErrRenewalLimitReached
+		}
+		if OverdueDays(loan.DueDate, s.now()) > s.policy.RenewalMaxOverdueDays {
+			return model.ErrLoanOverdue
+		}
+
+		bookCopy, err := s.copies.FindById(ctx, loan.CopyID)
+		if err != nil {
+			return err
+		}
+		reserved, err := s.reservations.ExistsActiveForBook(ctx, bookCopy.BookID, loan.MemberID)
+		if err != nil {
+			return err
+		}
+		if reserved {
+			return model.ErrBookReservedByAnother
+		}
+
+		loan.DueDate = loan.DueDate.AddDate(0, 0, category.LoanPeriodDays)
+		loan.RenewalCount++
+		return s.loans.Renew(ctx, loan)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+// heldFor returns the reservation an unavailable copy is held for, as long
+// as it belongs to memberID. Copies that are not on the hold shelf are on loan.
+func (s *LoanService) heldFor(ctx context.Context, copyID, memberID uuid.UUID) (*model.Reservation, error) {
+	hold, err := s.reservations.FindReadyByCopy(ctx, copyID)
+	if errors.Is(err, model.ErrReservationNotFound) {
+		return nil, model.ErrCopyAlreadyOnLoan
+	}
+	if err != nil {
+		return nil, err
+	}
+	if hold.MemberID != memberID {
+		return nil, model.ErrCopyOnHoldShelf
+	}
+	return hold, nil
+}
+
+// CountOverdue counts the loans not returned by the end of their due date, by
+// the same calendar day rule the fines use.
+func (s *LoanService) CountOverdue(ctx context.Context) (int, error) {
+	now := s.now()
+	return s.loans.CountOverdue(ctx, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()))
+}
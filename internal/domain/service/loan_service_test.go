@@ -0,0 +1,456 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type loanMocks struct {
+	loans        *mockRepo.LoanRepoMock
+	copies       *mockRepo.BookCopyRepoMock
+	members      *mockRepo.MemberRepoMock
+	reservations *mockRepo.ReservationRepoMock
+}
+
+func newLoanService() (*service.LoanService, loanMocks) {
+	m := loanMocks{
+		loans:        new(mockRepo.LoanRepoMock),
+		copies:       new(mockRepo.BookCopyRepoMock),
+		members:      new(mockRepo.MemberRepoMock),
+		reservations: new(mockRepo.ReservationRepoMock),
+	}
+	policy := service.LoanPolicy{
+		RenewalMaxOverdueDays: 3,
+		HoldPickupDays:        service.DefaultHoldPickupDays,
+	}
+	return service.NewLoanService(mockRepo.TransactionMock{}, m.loans, m.copies, m.members, m.reservations,
+		service.CategoryFinePolicy{}, policy), m
+}
+
+func TestGivenActiveMemberAndAvailableCopyWhenCheckoutThenCreateLoan(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, member.ID, loan.MemberID)
+	assert.Equal(t, bookCopy.ID, loan.CopyID)
+	assert.Nil(t, loan.ReturnedAt)
+	assert.Equal(t, loan.LoanedAt.AddDate(0, 0, member.MembershipCategory.LoanPeriodDays), loan.DueDate)
+	m.loans.AssertExpectations(t)
+}
+
+func TestGivenDueDateWhenCheckoutThenUseIt(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	dueDate := time.Now().AddDate(0, 0, 3)
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, &dueDate)
+
+	assert.Nil(t, err)
+	assert.Equal(t, dueDate, loan.DueDate)
+}
+
+func TestGivenPastDueDateWhenCheckoutThenReturnInvalidDueDate(t *testing.T) {
+	svc, m := newLoanService()
+	dueDate := time.Now().AddDate(0, 0, -1)
+
+	loan, err := svc.Checkout(t.Context(), uuid.New(), uuid.New(), &dueDate)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrInvalidDueDate)
+	m.members.AssertNotCalled(t, "FindByIdForUpdate", mock.Anything)
+}
+
+func TestGivenInactiveMemberWhenCheckoutThenReturnMemberInactive(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().WithActive(false).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, uuid.New(), nil)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrMemberInactive)
+	m.copies.AssertNotCalled(t, "FindByIdForUpdate", mock.Anything)
+}
+
+func TestGivenRetiredCopyWhenCheckoutThenReturnCopyInactive(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithActive(false).WithAvailable(false).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrCopyInactive)
+	m.loans.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenCopyOnLoanWhenCheckoutThenReturnAlreadyOnLoan(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.reservations.On("FindReadyByCopy", bookCopy.ID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrCopyAlreadyOnLoan)
+}
+
+func TestGivenCopyHeldForMemberWhenCheckoutThenFulfilReservation(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	hold := builder.NewReservationBuilder().WithMemberID(member.ID).WithBookID(bookCopy.BookID).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.reservations.On("FindReadyByCopy", bookCopy.ID).Return(hold, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+	m.reservations.On("Fulfil", hold).Return(nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, member.ID, loan.MemberID)
+	assert.Equal(t, &loan.ID, hold.FulfilledLoanID)
+	m.reservations.AssertExpectations(t)
+}
+
+func TestGivenCopyHeldForAnotherMemberWhenCheckoutThenReturnOnHoldShelf(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	hold := builder.NewReservationBuilder().WithBookID(bookCopy.BookID).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.reservations.On("FindReadyByCopy", bookCopy.ID).Return(hold, nil)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrCopyOnHoldShelf)
+	m.loans.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenConcurrentCheckoutWhenSaveLoanThenReturnAlreadyOnLoan(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(model.ErrCopyAlreadyOnLoan)
+
+	loan, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, loan)
+	assert.ErrorIs(t, err, model.ErrCopyAlreadyOnLoan)
+}
+
+func TestGivenCopyOnLoanWhenReturnThenSetReturnedAt(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindActiveByCopy", loan.CopyID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("Close", loan).Return(nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("FindNextForBook", bookCopy.BookID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+	before := time.Now()
+
+	result, err := svc.Return(t.Context(), loan.CopyID)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.ReturnedAt)
+	assert.False(t, result.ReturnedAt.Before(before))
+	assert.Equal(t, 0, result.FineCents)
+	m.loans.AssertExpectations(t)
+}
+
+func TestGivenOverdueLoanWhenReturnThenChargeFine(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithDueDate(time.Now().AddDate(0, 0, -4)).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindActiveByCopy", loan.CopyID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("Close", loan).Return(nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("FindNextForBook", bookCopy.BookID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+
+	result, err := svc.Return(t.Context(), loan.CopyID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 100, result.FineCents)
+}
+
+func TestGivenMemberAtCategoryLoanLimitWhenCheckoutThenReturnLimitReached(t *testing.T) {
+	svc, m := newLoanService()
+	category := builder.NewMembershipCategoryBuilder().WithMaxLoans(3).Build()
+	member := builder.NewMemberBuilder().WithMembershipCategory(category).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(3, nil)
+
+	result, err := svc.Checkout(t.Context(), member.ID, uuid.New(), nil)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrLoanLimitReached)
+	m.copies.AssertNotCalled(t, "FindByIdForUpdate", mock.Anything)
+}
+
+func TestGivenMemberUnderCategoryLimitWhenCheckoutThenUseCategoryLoanPeriod(t *testing.T) {
+	svc, m := newLoanService()
+	category := builder.NewMembershipCategoryBuilder().WithMaxLoans(3).WithLoanPeriodDays(21).Build()
+	member := builder.NewMemberBuilder().WithMembershipCategory(category).Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(2, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+
+	result, err := svc.Checkout(t.Context(), member.ID, bookCopy.ID, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, result.LoanedAt.AddDate(0, 0, 21), result.DueDate)
+}
+
+func TestGivenCategoryTermsChangedWhenCheckoutThenUseNewTerms(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	first := builder.NewBookCopyBuilder().Build()
+	second := builder.NewBookCopyBuilder().Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", first.ID).Return(first, nil)
+	m.copies.On("FindByIdForUpdate", second.ID).Return(second, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+
+	before, err := svc.Checkout(t.Context(), member.ID, first.ID, nil)
+	assert.Nil(t, err)
+	member.MembershipCategory.LoanPeriodDays = 7
+	member.MembershipCategory.FineDailyRateCents = 100
+	after, err := svc.Checkout(t.Context(), member.ID, second.ID, nil)
+	assert.Nil(t, err)
+
+	assert.Equal(t, before.LoanedAt.AddDate(0, 0, 14), before.DueDate)
+	assert.Equal(t, after.LoanedAt.AddDate(0, 0, 7), after.DueDate)
+	assert.Equal(t, 300, service.CategoryFinePolicy{}.Fine(after, member, after.DueDate.AddDate(0, 0, 3)))
+}
+
+func TestGivenCopyWithoutLoanWhenReturnThenReturnNotOnLoan(t *testing.T) {
+	svc, m := newLoanService()
+	copyID := uuid.New()
+	m.loans.On("FindActiveByCopy", copyID).Return((*model.Loan)(nil), model.ErrCopyNotOnLoan)
+
+	result, err := svc.Return(t.Context(), copyID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrCopyNotOnLoan)
+	m.loans.AssertNotCalled(t, "Close", mock.Anything)
+}
+
+func TestGivenRenewableLoanWhenRenewThenExtendDueDateAndCount(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithRenewalCount(1).Build()
+	due := loan.DueDate
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	member := builder.NewMemberBuilder().WithID(loan.MemberID).Build()
+	m.members.On("FindById", loan.MemberID).Return(member, nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(false, nil)
+	m.loans.On("Renew", loan).Return(nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, due.AddDate(0, 0, member.MembershipCategory.LoanPeriodDays), result.DueDate)
+	assert.Equal(t, 2, result.RenewalCount)
+	m.loans.AssertExpectations(t)
+}
+
+func TestGivenLoanAtRenewalLimitWhenRenewThenReturnLimitReached(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithRenewalCount(2).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrRenewalLimitReached)
+	m.loans.AssertNotCalled(t, "Renew", mock.Anything)
+}
+
+func TestGivenLoanAtCategoryRenewalLimitWhenRenewThenReturnLimitReached(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithRenewalCount(1).Build()
+	category := builder.NewMembershipCategoryBuilder().WithRenewalLimit(1).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).
+		Return(builder.NewMemberBuilder().WithID(loan.MemberID).WithMembershipCategory(category).Build(), nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrRenewalLimitReached)
+	m.loans.AssertNotCalled(t, "Renew", mock.Anything)
+}
+
+func TestGivenCategoryLoanPeriodWhenRenewThenExtendByCategoryPeriod(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().Build()
+	due := loan.DueDate
+	category := builder.NewMembershipCategoryBuilder().WithLoanPeriodDays(28).WithRenewalLimit(3).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).
+		Return(builder.NewMemberBuilder().WithID(loan.MemberID).WithMembershipCategory(category).Build(), nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(false, nil)
+	m.loans.On("Renew", loan).Return(nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, due.AddDate(0, 0, 28), result.DueDate)
+}
+
+func TestGivenLoanOverduePastThresholdWhenRenewThenReturnOverdue(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithDueDate(time.Now().AddDate(0, 0, -4)).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrLoanOverdue)
+}
+
+func TestGivenLoanOverdueWithinThresholdWhenRenewThenRenew(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithDueDate(time.Now().AddDate(0, 0, -3)).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(false, nil)
+	m.loans.On("Renew", loan).Return(nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.RenewalCount)
+}
+
+func TestGivenBookReservedByAnotherMemberWhenRenewThenReturnReserved(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(true, nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrBookReservedByAnother)
+	m.loans.AssertNotCalled(t, "Renew", mock.Anything)
+}
+
+func TestGivenReturnedLoanWhenRenewThenReturnAlreadyReturned(t *testing.T) {
+	svc, m := newLoanService()
+	loan := builder.NewLoanBuilder().WithReturnedAt(time.Now()).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+
+	result, err := svc.Renew(t.Context(), loan.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrLoanAlreadyReturned)
+}
+
+func TestGivenBookWithHoldQueueWhenReturnThenShelveCopyForHeadOfQueue(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	hold := builder.NewReservationBuilder().WithBookID(bookCopy.BookID).Build()
+	m.loans.On("FindActiveByCopy", loan.CopyID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("Close", loan).Return(nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("FindNextForBook", bookCopy.BookID).Return(hold, nil)
+	m.reservations.On("MarkReady", hold).Return(nil)
+
+	result, err := svc.Return(t.Context(), loan.CopyID)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.ReturnedAt)
+	assert.Equal(t, &bookCopy.ID, hold.HeldCopyID)
+	assert.Equal(t, result.ReturnedAt.AddDate(0, 0, service.DefaultHoldPickupDays), *hold.PickupDeadline)
+	m.loans.AssertNotCalled(t, "Save", mock.Anything)
+	m.reservations.AssertExpectations(t)
+}
+
+func TestGivenMarkReadyFailsWhenReturnThenReturnError(t *testing.T) {
+	svc, m := newLoanService()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	hold := builder.NewReservationBuilder().WithBookID(bookCopy.BookID).Build()
+	m.loans.On("FindActiveByCopy", loan.CopyID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("Close", loan).Return(nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("FindNextForBook", bookCopy.BookID).Return(hold, nil)
+	m.reservations.On("MarkReady", hold).Return(model.ErrReservationNotActive)
+
+	result, err := svc.Return(t.Context(), loan.CopyID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrReservationNotActive)
+}
+
+func TestGivenOpenLoansWhenCountOverdueThenCountThoseDueBeforeToday(t *testing.T) {
+	svc, m := newLoanService()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	m.loans.On("CountOverdue", today).Return(3, nil)
+
+	count, err := svc.CountOverdue(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type MemberServiceInterface interface {
+	RegisterMember(ctx context.Context, member *model.Member) error
+	GetMembers(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error)
+	GetMember(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	GetMemberByEmail(ctx context.Context, email string) (*model.Member, error)
+	GetMemberDetail(ctx context.Context, id uuid.UUID) (*model.MemberDetail, error)
+	UpdateMember(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error)
+	ActivateMember(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	DeactivateMember(ctx context.Context, id uuid.UUID) (*model.Member, error)
+	DeleteMember(ctx context.Context, id uuid.UUID) error
+	RestoreMember(ctx context.Context, id uuid.UUID) (*model.Member, error)
+}
+
+type MemberService struct {
+	repo         port.MemberPort
+	categories   port.MembershipCategoryPort
+	loans        port.LoanPort
+	reservations port.ReservationPort
+	fines        FineServiceInterface
+}
+
+func NewMemberService(
+	repo port.MemberPort,
+	categories port.MembershipCategoryPort,
+	loans port.LoanPort,
+	reservations port.ReservationPort,
+	fines FineServiceInterface,
+) *MemberService {
+	return &MemberService{repo: repo, categories: categories, loans: loans, reservations: reservations, fines: fines}
+}
+
+// RegisterMember creates an active member. The full name and a valid email are
+// required; emails are stored lower-cased so lookups ignore case. Members
+// without a category join the standard one.
+func (s *MemberService) RegisterMember(ctx context.Context, member *model.Member) error {
+	member.FullName = strings.TrimSpace(member.FullName)
+	if member.FullName == "" {
+		return model.ErrInvalidMemberName
+	}
+	email, err := normalizeEmail(member.Email)
+	if err != nil {
+		return err
+	}
+	member.Email = email
+	if member.Category == "" {
+		member.Category = model.DefaultMemberCategory
+	}
+	if member.MembershipCategory, err = s.categories.FindByName(ctx, normalizeCategoryName(member.Category)); err != nil {
+		return err
+	}
+	member.Category = member.MembershipCategory.Name
+	member.ID = uuid.New()
+	member.IsActive = true
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) GetMembers(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error) {
+	return s.repo.FindAll(ctx, query)
+}
+
+func (s *MemberService) GetMember(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+func (s *MemberService) GetMemberByEmail(ctx context.Context, email string) (*model.Member, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindByEmail(ctx, email)
+}
+
+// GetMemberDetail gathers the member with their open loans, unsettled fines
+// and active reservations, each reservation with its place in the queue.
+func (s *MemberService) GetMemberDetail(ctx context.Context, id uuid.UUID) (*model.MemberDetail, error) {
+	member, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	loans, err := s.loans.FindActiveByMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	fines, err := s.fines.MemberFines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := s.reservations.FindActiveByMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reservations {
+		if reservations[i].Position, err = s.reservations.Position(ctx, &reservations[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	detail := &model.MemberDetail{
+		Member:               *member,
+		ActiveLoans:          loans,
+		OutstandingFineCents: fines.OutstandingCents,
+		OutstandingFines:     []model.LoanFine{},
+		ActiveReservations:   reservations,
+	}
+	for _, fine := range fines.Loans {
+		if fine.OutstandingCents > 0 {
+			detail.OutstandingFines = append(detail.OutstandingFines, fine)
+		}
+	}
+	return detail, nil
+}
+
+// UpdateMember changes the contact details or the category of a member.
+// Fields left empty in patch keep their current value.
+func (s *MemberService) UpdateMember(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error) {
+	patch.FullName = strings.TrimSpace(patch.FullName)
+	if patch.Email != "" {
+		email, err := normalizeEmail(patch.Email)
+		if err != nil {
+			return nil, err
+		}
+		patch.Email = email
+	}
+	if patch.Category != "" {
+		category, err := s.categories.FindByName(ctx, normalizeCategoryName(patch.Category))
+		if err != nil {
+			return nil, err
+		}
+		patch.Category = category.Name
+	}
+	return s.repo.Update(ctx, id, patch)
+}
+
+func (s *MemberService) ActivateMember(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return s.repo.SetActive(ctx, id, true)
+}
+
+// DeactivateMember stops a member from borrowing or reserving. Loans already
+// out stay open until returned and their holds are skipped by the queue.
+func (s *MemberService) DeactivateMember(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return s.repo.SetActive(ctx, id, false)
+}
+
+// DeleteMember soft-deletes a member without open loans or active
+// reservations. Their loan and fine history is kept.
+func (s *MemberService) DeleteMember(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *MemberService) RestoreMember(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return s.repo.Restore(ctx, id)
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", model.ErrInvalidMemberEmail
+	}
+	return email, nil
+}
@@ -0,0 +1,190 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type memberMocks struct {
+	repo         *mockRepo.MemberRepoMock
+	categories   *mockRepo.MembershipCategoryRepoMock
+	loans        *mockRepo.LoanRepoMock
+	reservations *mockRepo.ReservationRepoMock
+	transactions *mockRepo.FineTransactionRepoMock
+}
+
+func newMemberService() (*service.MemberService, memberMocks) {
+	m := memberMocks{
+		repo:         new(mockRepo.MemberRepoMock),
+		categories:   new(mockRepo.MembershipCategoryRepoMock),
+		loans:        new(mockRepo.LoanRepoMock),
+		reservations: new(mockRepo.ReservationRepoMock),
+		transactions: new(mockRepo.FineTransactionRepoMock),
+	}
+	fines := service.NewFineService(mockRepo.TransactionMock{}, m.loans, m.repo, m.transactions,
+		service.CategoryFinePolicy{})
+	return service.NewMemberService(m.repo, m.categories, m.loans, m.reservations, fines), m
+}
+
+func TestGivenValidMemberWhenRegisterMemberThenSaveActiveWithNormalizedEmail(t *testing.T) {
+	svc, m := newMemberService()
+	member := &model.Member{FullName: "  Ada Lovelace ", Email: " Ada@Example.COM "}
+	standard := builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build()
+	m.categories.On("FindByName", model.DefaultMemberCategory).Return(standard, nil)
+	m.repo.On("Save", member).Return(nil)
+
+	err := svc.RegisterMember(t.Context(), member)
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, member.ID)
+	assert.True(t, member.IsActive)
+	assert.Equal(t, "Ada Lovelace", member.FullName)
+	assert.Equal(t, "ada@example.com", member.Email)
+	assert.Equal(t, model.DefaultMemberCategory, member.Category)
+	assert.Equal(t, standard, member.MembershipCategory)
+	m.repo.AssertExpectations(t)
+}
+
+func TestGivenUnknownCategoryWhenRegisterMemberThenReturnCategoryNotFound(t *testing.T) {
+	svc, m := newMemberService()
+	m.categories.On("FindByName", "vip").
+		Return((*model.MembershipCategory)(nil), model.ErrMembershipCategoryNotFound)
+
+	err := svc.RegisterMember(t.Context(), &model.Member{FullName: "Ada", Email: "ada@example.com", Category: "VIP"})
+
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryNotFound)
+	m.repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenPatchWithCategoryWhenUpdateMemberThenStoreCanonicalName(t *testing.T) {
+	svc, m := newMemberService()
+	staff := builder.NewMembershipCategoryBuilder().WithName("staff").Build()
+	member := builder.NewMemberBuilder().WithMembershipCategory(staff).Build()
+	m.categories.On("FindByName", "staff").Return(staff, nil)
+	m.repo.On("Update", member.ID, mock.MatchedBy(func(p *model.Member) bool {
+		return p.Category == "staff"
+	})).Return(member, nil)
+
+	result, err := svc.UpdateMember(t.Context(), member.ID, &model.Member{Category: " Staff "})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "staff", result.Category)
+}
+
+func TestGivenMissingNameWhenRegisterMemberThenReturnInvalidName(t *testing.T) {
+	svc, m := newMemberService()
+
+	err := svc.RegisterMember(t.Context(), &model.Member{FullName: " ", Email: "ada@example.com"})
+
+	assert.ErrorIs(t, err, model.ErrInvalidMemberName)
+	m.repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenInvalidEmailWhenRegisterMemberThenReturnInvalidEmail(t *testing.T) {
+	svc, m := newMemberService()
+
+	err := svc.RegisterMember(t.Context(), &model.Member{FullName: "Ada", Email: "Ada <ada@example.com>"})
+
+	assert.ErrorIs(t, err, model.ErrInvalidMemberEmail)
+	m.repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenMixedCaseEmailWhenGetMemberByEmailThenLookUpLowerCase(t *testing.T) {
+	svc, m := newMemberService()
+	member := builder.NewMemberBuilder().Build()
+	m.repo.On("FindByEmail", member.Email).Return(member, nil)
+
+	result, err := svc.GetMemberByEmail(t.Context(), "John.Doe@Example.com")
+
+	assert.Nil(t, err)
+	assert.Equal(t, member, result)
+}
+
+func TestGivenPatchWithInvalidEmailWhenUpdateMemberThenReturnInvalidEmail(t *testing.T) {
+	svc, m := newMemberService()
+
+	result, err := svc.UpdateMember(t.Context(), uuid.New(), &model.Member{Email: "not-an-email"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrInvalidMemberEmail)
+	m.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestGivenActiveMemberWhenDeactivateMemberThenSetInactive(t *testing.T) {
+	svc, m := newMemberService()
+	member := builder.NewMemberBuilder().WithActive(false).Build()
+	m.repo.On("SetActive", member.ID, false).Return(member, nil)
+
+	result, err := svc.DeactivateMember(t.Context(), member.ID)
+
+	assert.Nil(t, err)
+	assert.False(t, result.IsActive)
+}
+
+func TestGivenMemberWithOpenLoansWhenDeleteMemberThenReturnHasOpenLoans(t *testing.T) {
+	svc, m := newMemberService()
+	id := uuid.New()
+	m.repo.On("Delete", id).Return(model.ErrMemberHasOpenLoans)
+
+	err := svc.DeleteMember(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrMemberHasOpenLoans)
+}
+
+func TestGivenEmailTakenWhenRestoreMemberThenReturnEmailConflict(t *testing.T) {
+	svc, m := newMemberService()
+	id := uuid.New()
+	m.repo.On("Restore", id).Return((*model.Member)(nil), model.ErrMemberEmailConflict)
+
+	result, err := svc.RestoreMember(t.Context(), id)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrMemberEmailConflict)
+}
+
+func TestGivenMemberWithLoansFinesAndHoldsWhenGetMemberDetailThenAggregate(t *testing.T) {
+	svc, m := newMemberService()
+	member := builder.NewMemberBuilder().Build()
+	overdue := builder.NewLoanBuilder().WithMemberID(member.ID).WithDueDate(time.Now().AddDate(0, 0, -2)).Build()
+	settled := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(100).Build()
+	payment := builder.NewFineTransactionBuilder().WithLoanID(settled.ID).WithAmountCents(100).Build()
+	hold := builder.NewReservationBuilder().WithMemberID(member.ID).Build()
+	m.repo.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("FindActiveByMember", member.ID).Return([]model.Loan{*overdue}, nil)
+	m.loans.On("FindByMember", member.ID).Return([]model.Loan{*overdue, *settled}, nil)
+	m.transactions.On("FindByMember", member.ID).Return([]model.FineTransaction{*payment}, nil)
+	m.reservations.On("FindActiveByMember", member.ID).Return([]model.Reservation{*hold}, nil)
+	m.reservations.On("Position", mock.AnythingOfType("*model.Reservation")).Return(2, nil)
+
+	detail, err := svc.GetMemberDetail(t.Context(), member.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, member.ID, detail.ID)
+	assert.Len(t, detail.ActiveLoans, 1)
+	assert.Equal(t, 50, detail.OutstandingFineCents)
+	assert.Len(t, detail.OutstandingFines, 1)
+	assert.Equal(t, overdue.ID, detail.OutstandingFines[0].LoanID)
+	assert.Len(t, detail.ActiveReservations, 1)
+	assert.Equal(t, 2, detail.ActiveReservations[0].Position)
+}
+
+func TestGivenUnknownMemberWhenGetMemberDetailThenReturnNotFound(t *testing.T) {
+	svc, m := newMemberService()
+	id := uuid.New()
+	m.repo.On("FindById", id).Return((*model.Member)(nil), model.ErrMemberNotFound)
+
+	detail, err := svc.GetMemberDetail(t.Context(), id)
+
+	assert.Nil(t, detail)
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+	m.loans.AssertNotCalled(t, "FindActiveByMember", mock.Anything)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type MembershipCategoryServiceInterface interface {
+	CreateCategory(ctx context.Context, category *model.MembershipCategory) error
+	GetCategories(ctx context.Context, query port.ListQuery) (*port.Page[model.MembershipCategory], error)
+	GetCategory(ctx context.Context, name string) (*model.MembershipCategory, error)
+	UpdateCategory(ctx context.Context, name string, category *model.MembershipCategory) (*model.MembershipCategory, error)
+}
+
+type MembershipCategoryService struct {
+	repo port.MembershipCategoryPort
+}
+
+func NewMembershipCategoryService(repo port.MembershipCategoryPort) *MembershipCategoryService {
+	return &MembershipCategoryService{repo}
+}
+
+// CreateCategory stores a new category. Names are lower-cased so members can
+// refer to them regardless of case.
+func (s *MembershipCategoryService) CreateCategory(ctx context.Context, category *model.MembershipCategory) error {
+	category.Name = normalizeCategoryName(category.Name)
+	if !category.IsValid() {
+		return model.ErrInvalidMembershipCategory
+	}
+	return s.repo.Save(ctx, category)
+}
+
+func (s *MembershipCategoryService) GetCategories(ctx context.Context, query port.ListQuery) (*port.Page[model.MembershipCategory], error) {
+	return s.repo.FindAll(ctx, query)
+}
+
+func (s *MembershipCategoryService) GetCategory(ctx context.Context, name string) (*model.MembershipCategory, error) {
+	return s.repo.FindByName(ctx, normalizeCategoryName(name))
+}
+
+// UpdateCategory replaces every term of a category. The new terms apply to
+// checkouts, renewals and fines computed from now on.
+func (s *MembershipCategoryService) UpdateCategory(
+	ctx context.Context,
+	name string,
+	category *model.MembershipCategory,
+) (*model.MembershipCategory, error) {
+	category.Name = normalizeCategoryName(name)
+	if !category.IsValid() {
+		return nil, model.ErrInvalidMembershipCategory
+	}
+	return s.repo.Update(ctx, category.Name, category)
+}
+
+func normalizeCategoryName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
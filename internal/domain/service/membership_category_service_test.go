@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenCategoryWhenCreateCategoryThenStoreLowerCasedName(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+	category := builder.NewMembershipCategoryBuilder().WithName(" Student ").Build()
+	repo.On("Save", category).Return(nil)
+
+	err := svc.CreateCategory(t.Context(), category)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "student", category.Name)
+	repo.AssertExpectations(t)
+}
+
+func TestGivenCategoryWithoutLoansWhenCreateCategoryThenReturnInvalid(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+
+	err := svc.CreateCategory(t.Context(), builder.NewMembershipCategoryBuilder().WithMaxLoans(0).Build())
+
+	assert.ErrorIs(t, err, model.ErrInvalidMembershipCategory)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenMixedCaseNameWhenGetCategoryThenLookUpLowerCased(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+	category := builder.NewMembershipCategoryBuilder().Build()
+	repo.On("FindByName", "student").Return(category, nil)
+
+	result, err := svc.GetCategory(t.Context(), "STUDENT")
+
+	assert.Nil(t, err)
+	assert.Equal(t, category, result)
+}
+
+func TestGivenNewTermsWhenUpdateCategoryThenUseNameFromPath(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+	terms := builder.NewMembershipCategoryBuilder().WithName("ignored").WithMaxLoans(5).Build()
+	updated := builder.NewMembershipCategoryBuilder().WithName("staff").WithMaxLoans(5).Build()
+	repo.On("Update", "staff", terms).Return(updated, nil)
+
+	result, err := svc.UpdateCategory(t.Context(), "Staff", terms)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "staff", terms.Name)
+	assert.Equal(t, 5, result.MaxLoans)
+}
+
+func TestGivenNegativeFineWhenUpdateCategoryThenReturnInvalid(t *testing.T) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+
+	result, err := svc.UpdateCategory(t.Context(), "staff", builder.NewMembershipCategoryBuilder().WithFine(-1, 0, 0).Build())
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrInvalidMembershipCategory)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type ReservationServiceInterface interface {
+	PlaceHold(ctx context.Context, bookID, memberID uuid.UUID) (*model.Reservation, error)
+	GetReservation(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	GetBookQueue(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error)
+	CancelHold(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	GetHoldShelf(ctx context.Context) ([]model.Reservation, error)
+	ExpireStaleHolds(ctx context.Context) (int, error)
+}
+
+// expireBatchSize bounds how many holds one sweep expires.
+const expireBatchSize = 100
+
+type ReservationService struct {
+	tx      port.TransactionPort
+	repo    port.ReservationPort
+	books   port.BookPort
+	members port.MemberPort
+	copies  port.BookCopyPort
+	policy  LoanPolicy
+	now     func() time.Time
+}
+
+func NewReservationService(
+	tx port.TransactionPort,
+	repo port.ReservationPort,
+	books port.BookPort,
+	members port.MemberPort,
+	copies port.BookCopyPort,
+	policy LoanPolicy,
+) *ReservationService {
+	return &ReservationService{
+		tx:      tx,
+		repo:    repo,
+		books:   books,
+		members: members,
+		copies:  copies,
+		policy:  policy,
+		now:     time.Now,
+	}
+}
+
+// PlaceHold queues an active member for a book. A member can hold a book only
+// once at a time.
+func (s *ReservationService) PlaceHold(ctx context.Context, bookID, memberID uuid.UUID) (*model.Reservation, error) {
+	member, err := s.members.FindById(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.IsActive {
+		return nil, model.ErrMemberInactive
+	}
+	if _, err := s.books.FindById(ctx, bookID); err != nil {
+		return nil, err
+	}
+
+	reservation := &model.Reservation{
+		ID:         uuid.New(),
+		MemberID:   memberID,
+		BookID:     bookID,
+		ReservedAt: s.now(),
+	}
+	if err := s.repo.Save(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return s.withPosition(ctx, reservation)
+}
+
+func (s *ReservationService) GetReservation(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	reservation, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withPosition(ctx, reservation)
+}
+
+func (s *ReservationService) GetBookQueue(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error) {
+	if _, err := s.books.FindById(ctx, bookID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindActiveByBook(ctx, bookID)
+}
+
+// CancelHold takes a reservation out of the queue by setting CanceledAt. A copy
+// already on the hold shelf for it moves on to the next member in the queue.
+func (s *ReservationService) CancelHold(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	var reservation *model.Reservation
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reservation, err = s.repo.FindById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !reservation.IsActive() {
+			return model.ErrReservationNotActive
+		}
+
+		canceledAt := s.now()
+		reservation.CanceledAt = &canceledAt
+		if err := s.repo.Cancel(ctx, reservation); err != nil {
+			return err
+		}
+		return s.releaseHeldCopy(ctx, reservation, canceledAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// GetHoldShelf lists the copies set aside for pickup, closest deadline first.
+func (s *ReservationService) GetHoldShelf(ctx context.Context) ([]model.Reservation, error) {
+	return s.repo.FindHoldShelf(ctx)
+}
+
+// ExpireStaleHolds expires holds whose pickup deadline has passed and passes
+// each copy on to the next member waiting for the book. It returns how many
+// holds were expired.
+func (s *ReservationService) ExpireStaleHolds(ctx context.Context) (int, error) {
+	var expired int
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := s.now()
+		stale, err := s.repo.FindExpiredHolds(ctx, now, expireBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range stale {
+			reservation := &stale[i]
+			reservation.ExpiredAt = &now
+			if err := s.repo.Expire(ctx, reservation); err != nil {
+				return err
+			}
+			if err := s.releaseHeldCopy(ctx, reservation, now); err != nil {
+				return err
+			}
+		}
+		expired = len(stale)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// releaseHeldCopy hands the copy held for a closed reservation to the next
+// member in the queue.
+func (s *ReservationService) releaseHeldCopy(ctx context.Context, reservation *model.Reservation, now time.Time) error {
+	if reservation.HeldCopyID == nil {
+		return nil
+	}
+	bookCopy, err := s.copies.FindById(ctx, *reservation.HeldCopyID)
+	if err != nil {
+		return err
+	}
+	return shelveForNextHold(ctx, s.repo, bookCopy, now, s.policy.HoldPickupDays)
+}
+
+func (s *ReservationService) withPosition(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error) {
+	if !reservation.IsActive() {
+		return reservation, nil
+	}
+	position, err := s.repo.Position(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+	reservation.Position = position
+	return reservation, nil
+}
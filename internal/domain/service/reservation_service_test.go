@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type reservationMocks struct {
+	repo    *mockRepo.ReservationRepoMock
+	books   *mockRepo.BookRepoMock
+	members *mockRepo.MemberRepoMock
+	copies  *mockRepo.BookCopyRepoMock
+}
+
+func newReservationService() (*service.ReservationService, reservationMocks) {
+	m := reservationMocks{
+		repo:    new(mockRepo.ReservationRepoMock),
+		books:   new(mockRepo.BookRepoMock),
+		members: new(mockRepo.MemberRepoMock),
+		copies:  new(mockRepo.BookCopyRepoMock),
+	}
+	svc := service.NewReservationService(
+		mockRepo.TransactionMock{}, m.repo, m.books, m.members, m.copies, service.DefaultLoanPolicy())
+	return svc, m
+}
+
+func TestGivenActiveMemberWhenPlaceHoldThenQueueReservation(t *testing.T) {
+	svc, m := newReservationService()
+	member := builder.NewMemberBuilder().Build()
+	book := builder.NewBookBuilder().Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.repo.On("Save", mock.AnythingOfType("*model.Reservation")).Return(nil)
+	m.repo.On("Position", mock.AnythingOfType("*model.Reservation")).Return(3, nil)
+
+	reservation, err := svc.PlaceHold(t.Context(), book.ID, member.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, book.ID, reservation.BookID)
+	assert.Equal(t, member.ID, reservation.MemberID)
+	assert.Equal(t, 3, reservation.Position)
+}
+
+func TestGivenInactiveMemberWhenPlaceHoldThenReturnMemberInactive(t *testing.T) {
+	svc, m := newReservationService()
+	member := builder.NewMemberBuilder().WithActive(false).Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+
+	reservation, err := svc.PlaceHold(t.Context(), uuid.New(), member.ID)
+
+	assert.Nil(t, reservation)
+	assert.ErrorIs(t, err, model.ErrMemberInactive)
+	m.repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenExistingHoldWhenPlaceHoldThenReturnAlreadyActive(t *testing.T) {
+	svc, m := newReservationService()
+	member := builder.NewMemberBuilder().Build()
+	book := builder.NewBookBuilder().Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.repo.On("Save", mock.AnythingOfType("*model.Reservation")).Return(model.ErrReservationAlreadyActive)
+
+	reservation, err := svc.PlaceHold(t.Context(), book.ID, member.ID)
+
+	assert.Nil(t, reservation)
+	assert.ErrorIs(t, err, model.ErrReservationAlreadyActive)
+}
+
+func TestGivenActiveReservationWhenGetReservationThenIncludePosition(t *testing.T) {
+	svc, m := newReservationService()
+	reservation := builder.NewReservationBuilder().Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+	m.repo.On("Position", reservation).Return(1, nil)
+
+	result, err := svc.GetReservation(t.Context(), reservation.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Position)
+}
+
+func TestGivenFulfilledReservationWhenGetReservationThenOmitPosition(t *testing.T) {
+	svc, m := newReservationService()
+	reservation := builder.NewReservationBuilder().WithFulfilledLoanID(uuid.New()).Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+
+	result, err := svc.GetReservation(t.Context(), reservation.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.Position)
+	m.repo.AssertNotCalled(t, "Position", mock.Anything)
+}
+
+func TestGivenUnknownBookWhenGetBookQueueThenReturnNotFound(t *testing.T) {
+	svc, m := newReservationService()
+	id := uuid.New()
+	m.books.On("FindById", id).Return((*model.Book)(nil), model.ErrBookNotFound)
+
+	queue, err := svc.GetBookQueue(t.Context(), id)
+
+	assert.Nil(t, queue)
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+}
+
+func TestGivenActiveReservationWhenCancelHoldThenSetCanceledAt(t *testing.T) {
+	svc, m := newReservationService()
+	reservation := builder.NewReservationBuilder().Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+	m.repo.On("Cancel", reservation).Return(nil)
+
+	result, err := svc.CancelHold(t.Context(), reservation.ID)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.CanceledAt)
+	m.repo.AssertExpectations(t)
+}
+
+func TestGivenCanceledReservationWhenCancelHoldThenReturnNotActive(t *testing.T) {
+	svc, m := newReservationService()
+	reservation := builder.NewReservationBuilder().WithCanceledAt(time.Now()).Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+
+	result, err := svc.CancelHold(t.Context(), reservation.ID)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, model.ErrReservationNotActive)
+	m.repo.AssertNotCalled(t, "Cancel", mock.Anything)
+}
+
+func TestGivenReadyReservationWhenCancelHoldThenShelveCopyForNextInQueue(t *testing.T) {
+	svc, m := newReservationService()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	reservation := builder.NewReservationBuilder().
+		WithBookID(bookCopy.BookID).WithHeldCopy(bookCopy.ID, time.Now().AddDate(0, 0, 2)).Build()
+	next := builder.NewReservationBuilder().WithBookID(bookCopy.BookID).Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+	m.repo.On("Cancel", reservation).Return(nil)
+	m.copies.On("FindById", bookCopy.ID).Return(bookCopy, nil)
+	m.repo.On("FindNextForBook", bookCopy.BookID).Return(next, nil)
+	m.repo.On("MarkReady", next).Return(nil)
+
+	result, err := svc.CancelHold(t.Context(), reservation.ID)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.CanceledAt)
+	assert.Equal(t, &bookCopy.ID, next.HeldCopyID)
+	assert.NotNil(t, next.PickupDeadline)
+	m.repo.AssertExpectations(t)
+}
+
+func TestGivenStaleHoldsWhenExpireStaleHoldsThenExpireAndReleaseCopies(t *testing.T) {
+	svc, m := newReservationService()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	stale := builder.NewReservationBuilder().
+		WithBookID(bookCopy.BookID).WithHeldCopy(bookCopy.ID, time.Now().AddDate(0, 0, -1)).Build()
+	m.repo.On("FindExpiredHolds", mock.AnythingOfType("time.Time"), mock.AnythingOfType("int")).
+		Return([]model.Reservation{*stale}, nil)
+	m.repo.On("Expire", mock.MatchedBy(func(r *model.Reservation) bool {
+		return r.ID == stale.ID && r.ExpiredAt != nil
+	})).Return(nil)
+	m.copies.On("FindById", bookCopy.ID).Return(bookCopy, nil)
+	m.repo.On("FindNextForBook", bookCopy.BookID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+
+	expired, err := svc.ExpireStaleHolds(t.Context())
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, expired)
+	m.repo.AssertExpectations(t)
+	m.repo.AssertNotCalled(t, "MarkReady", mock.Anything)
+}
+
+func TestGivenExpireFailsWhenExpireStaleHoldsThenReturnError(t *testing.T) {
+	svc, m := newReservationService()
+	stale := builder.NewReservationBuilder().WithHeldCopy(uuid.New(), time.Now().AddDate(0, 0, -1)).Build()
+	m.repo.On("FindExpiredHolds", mock.AnythingOfType("time.Time"), mock.AnythingOfType("int")).
+		Return([]model.Reservation{*stale}, nil)
+	m.repo.On("Expire", mock.AnythingOfType("*model.Reservation")).Return(model.ErrReservationNotActive)
+
+	expired, err := svc.ExpireStaleHolds(t.Context())
+
+	assert.Equal(t, 0, expired)
+	assert.ErrorIs(t, err, model.ErrReservationNotActive)
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// maxSearchLength bounds the text of a search, in characters.
+const maxSearchLength = 200
+
+type SearchServiceInterface interface {
+	Search(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error)
+}
+
+type SearchService struct {
+	repo port.SearchPort
+}
+
+func NewSearchService(repo port.SearchPort) *SearchService {
+	return &SearchService{repo}
+}
+
+// Search ranks the books matching query. The first page of DefaultListLimit
+// books is returned unless the query asks for another.
+func (s *SearchService) Search(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" || utf8.RuneCountInString(query.Text) > maxSearchLength {
+		return nil, model.ErrInvalidSearchQuery
+	}
+	if query.Limit <= 0 {
+		query.Limit = port.DefaultListLimit
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	return s.repo.SearchBooks(ctx, query)
+}
@@ -0,0 +1,48 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	mockRepo "library/internal/test/mock"
+)
+
+func TestGivenTextWithoutPagingWhenSearchThenReadFirstDefaultPage(t *testing.T) {
+	repo := new(mockRepo.SearchRepoMock)
+	svc := service.NewSearchService(repo)
+	result := &model.SearchResult{Total: 1}
+	repo.On("SearchBooks", port.SearchQuery{Text: "dune", Limit: port.DefaultListLimit, Page: 1}).Return(result, nil)
+
+	got, err := svc.Search(t.Context(), port.SearchQuery{Text: "  dune "})
+
+	assert.Nil(t, err)
+	assert.Equal(t, result, got)
+	repo.AssertExpectations(t)
+}
+
+func TestGivenBlankTextWhenSearchThenReturnInvalidQuery(t *testing.T) {
+	repo := new(mockRepo.SearchRepoMock)
+	svc := service.NewSearchService(repo)
+
+	got, err := svc.Search(t.Context(), port.SearchQuery{Text: "   "})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrInvalidSearchQuery)
+	repo.AssertNotCalled(t, "SearchBooks", mock.Anything)
+}
+
+func TestGivenTooLongTextWhenSearchThenReturnInvalidQuery(t *testing.T) {
+	repo := new(mockRepo.SearchRepoMock)
+	svc := service.NewSearchService(repo)
+
+	_, err := svc.Search(t.Context(), port.SearchQuery{Text: strings.Repeat("a", 201)})
+
+	assert.ErrorIs(t, err, model.ErrInvalidSearchQuery)
+	repo.AssertNotCalled(t, "SearchBooks", mock.Anything)
+}
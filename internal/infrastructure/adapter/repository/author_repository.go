@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"library/internal/domain/model"
 	"library/internal/domain/port"
@@ -22,37 +24,131 @@ func NewAuthorRepository(db *gorm.DB) port.AuthorPort {
 func (r *AuthorRepositoryImpl) Save(ctx context.Context, author *model.Author) error {
 	err := r.db.WithContext(ctx).Create(author).Error
 	if err != nil {
-		return err
+		slog.ErrorContext(ctx, "Failed to save author", "error", err)
+		return translateError(err)
 	}
-	slog.Info("Author save successful", "author", author)
+	slog.InfoContext(ctx, "Author save successful", "author_id", author.ID)
 	return nil
 }
 
-func (r *AuthorRepositoryImpl) FindAll(ctx context.Context) ([]model.Author, error) {
-	var authors []model.Author
-	err := r.db.WithContext(ctx).Find(&authors).Error
+var authorList = listSpec[model.Author]{
+	key:         "id",
+	keyCast:     "uuid",
+	keyOf:       func(a *model.Author) string { return a.ID.String() },
+	defaultSort: "created_at",
+	sorts: map[string]sortColumn[model.Author]{
+		"created_at": {cast: "timestamptz", value: func(a *model.Author) string { return timeValue(a.CreatedAt) }},
+		"last_name":  {cast: "text", value: func(a *model.Author) string { return a.LastName }},
+		"first_name": {cast: "text", value: func(a *model.Author) string { return a.FirstName }},
+	},
+	filters: map[string]listFilter{
+		"last_name":  prefixFilter("last_name"),
+		"first_name": prefixFilter("first_name"),
+	},
+}
+
+func (r *AuthorRepositoryImpl) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error) {
+	page, err := findPage(readFrom(ctx, r.db), query, authorList)
 	if err != nil {
-		slog.Error("Failed to find authors", "error", err)
-		return nil, err
+		slog.ErrorContext(ctx, "Failed to find authors", "error", err)
+		return nil, translateError(err)
 	}
-
-	return authors, err
+	return page, nil
 }
 
 func (r *AuthorRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.Author, error) {
 	var author model.Author
-	err := r.db.WithContext(ctx).First(&author, id).Error
+	err := readFrom(ctx, r.db).First(&author, id).Error
 	if err != nil {
-		slog.Error("Failed to find author", "error", err)
+		slog.ErrorContext(ctx, "Failed to find author", "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrAuthorNotFound
+		}
 		return nil, err
 	}
 	return &author, err
 }
 
 func (r *AuthorRepositoryImpl) Update(ctx context.Context, id uuid.UUID, patch *model.Author) (*model.Author, error) {
-	if err := r.db.WithContext(ctx).Model(&model.Author{}).Where("id = ?", id).Updates(patch).Error; err != nil {
-		slog.Error("Failed to update author", "error", err)
-		return nil, err
+	return r.update(ctx, id, patch)
+}
+
+func (r *AuthorRepositoryImpl) Patch(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error) {
+	fields := map[string]any{}
+	if patch.FirstName != nil {
+		fields["first_name"] = *patch.FirstName
+	}
+	if patch.LastName != nil {
+		fields["last_name"] = *patch.LastName
+	}
+	if patch.Bio != nil {
+		fields["bio"] = *patch.Bio
+	}
+	return r.update(ctx, id, fields)
+}
+
+// update applies values, a *model.Author or a column map, and reads the row
+// back in the same statement.
+func (r *AuthorRepositoryImpl) update(ctx context.Context, id uuid.UUID, values any) (*model.Author, error) {
+	var author model.Author
+	result := dbFrom(ctx, r.db).Model(&author).
+		Clauses(clause.Returning{}).
+		Omit(clause.Associations, "id", "created_at").
+		Where("id = ?", id).
+		Updates(values)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update author", "error", result.Error)
+		return nil, translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrAuthorNotFound
+	}
+	return &author, nil
+}
+
+// Delete locks the author row before counting its links, so a book cannot be
+// linked to the author between the check and the delete. The links are left in
+// place for Restore.
+func (r *AuthorRepositoryImpl) Delete(ctx context.Context, id uuid.UUID, force bool) error {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var author model.Author
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&author).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrAuthorNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var links int64
+		if err := tx.Model(&model.BookAuthor{}).Where("author_id = ? AND "+linkToLiveBook, id).Count(&links).Error; err != nil {
+			return err
+		}
+		if links > 0 && !force {
+			return model.ErrAuthorHasBooks
+		}
+		return tx.Delete(&author).Error
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete author", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Author delete successful", "author_id", id, "force", force)
+	return nil
+}
+
+func (r *AuthorRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	var author model.Author
+	result := dbFrom(ctx, r.db).Unscoped().Model(&author).
+		Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		UpdateColumn("deleted_at", nil)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to restore author", "error", result.Error)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrAuthorNotFound
 	}
-	return nil, nil
+	return &author, nil
 }
@@ -4,12 +4,17 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
+	"library/internal/domain/domainerror"
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 	"library/internal/infrastructure/adapter/repository"
 	"library/internal/test/builder"
 	"library/internal/test/mock"
@@ -19,7 +24,10 @@ const (
 	authorInsertQuery     = "INSERT INTO authors"
 	authorsSelectAllRegex = `^SELECT .* FROM "authors"`
 	authorSelectByIDRegex = `^SELECT .* FROM "authors" WHERE "authors"\."id" = \$1`
-	authorUpdateRegex     = `^UPDATE "authors" SET .* WHERE id = \$\d+`
+	authorUpdateRegex     = `^UPDATE "authors" SET .* WHERE id = \$\d+ AND "authors"\."deleted_at" IS NULL RETURNING \*`
+	authorLockRegex       = `^SELECT \* FROM "authors" WHERE id = \$1 AND "authors"."deleted_at" IS NULL LIMIT \$2 FOR UPDATE`
+	authorSoftDeleteRegex = `^UPDATE "authors" SET "deleted_at"=\$1 WHERE "authors"."id" = \$2 AND "authors"."deleted_at" IS NULL`
+	authorLinksCountRegex = `^SELECT count\(\*\) FROM "book_authors" WHERE author_id = \$1`
 	errInsertFailed       = "insert failed"
 	errSelectFailed       = "select failed"
 	errUpdateFailed       = "update failed"
@@ -59,20 +67,102 @@ func TestGivenDBErrorWhenSaveThenReturnsError(t *testing.T) {
 	assert.NoError(t, sqlMock.ExpectationsWereMet(), "not all SQL expectations were met")
 }
 
-func TestGivenAuthorsWhenFindAllThenReturnsSlice(t *testing.T) {
+func TestGivenMoreAuthorsThanLimitWhenFindAllThenReturnsPageWithNextCursor(t *testing.T) {
 	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
 	repo := repository.NewAuthorRepository(gdb)
 	a1 := builder.NewAuthorBuilder().Build()
 	a2 := builder.NewAuthorBuilder().Build()
-	sqlMock.ExpectQuery(authorsSelectAllRegex).
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "authors" WHERE "authors"."deleted_at" IS NULL$`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "authors" WHERE "authors"."deleted_at" IS NULL ` +
+		`ORDER BY "created_at","id" LIMIT \$1$`).
+		WithArgs(2).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "bio", "created_at", "updated_at"}).
 			AddRow(a1.ID, a1.FirstName, a1.LastName, a1.Bio, a1.CreatedAt, a1.UpdatedAt).
 			AddRow(a2.ID, a2.FirstName, a2.LastName, a2.Bio, a2.CreatedAt, a2.UpdatedAt))
 
-	authors, err := repo.FindAll(t.Context())
+	page, err := repo.FindAll(t.Context(), port.ListQuery{Limit: 1})
 
 	assert.NoError(t, err)
-	assert.Len(t, authors, 2)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, a1.ID, page.Items[0].ID)
+	assert.Equal(t, int64(5), page.Total)
+	assert.Equal(t, &port.Cursor{Value: a1.CreatedAt.UTC().Format(time.RFC3339Nano), Key: a1.ID.String()}, page.Next)
+	assert.Nil(t, page.Prev)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCursorBeforeWhenFindAllThenReadsBackwardsInPageOrder(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a1 := builder.NewAuthorBuilder().WithID(uuid.New()).Build()
+	a2 := builder.NewAuthorBuilder().WithID(uuid.New()).Build()
+	key := uuid.New()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "authors" WHERE last_name ILIKE \$1`).
+		WithArgs(`Garc\%%`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "authors" WHERE last_name ILIKE \$1 `+
+		`AND \(last_name, id\) < \(CAST\(\$2 AS text\), \$3\) AND "authors"."deleted_at" IS NULL `+
+		`ORDER BY "last_name" DESC,"id" DESC LIMIT \$4$`).
+		WithArgs(`Garc\%%`, "Márquez", key, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "last_name"}).
+			AddRow(a2.ID, "B").
+			AddRow(a1.ID, "A"))
+
+	page, err := repo.FindAll(t.Context(), port.ListQuery{
+		Limit:   2,
+		Sort:    "last_name",
+		Cursor:  &port.Cursor{Value: "Márquez", Key: key.String(), Before: true},
+		Filters: map[string]string{"last_name": "Garc%"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, a1.ID, page.Items[0].ID)
+	assert.Equal(t, a2.ID, page.Items[1].ID)
+	assert.Equal(t, &port.Cursor{Value: "B", Key: a2.ID.String()}, page.Next)
+	assert.Nil(t, page.Prev)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCursorWithMalformedValueWhenFindAllThenReturnsInvalidCursor(t *testing.T) {
+	for name, cursor := range map[string]port.Cursor{
+		"timestamp": {Value: "yesterday", Key: uuid.NewString()},
+		"key":       {Value: "2025-03-10T09:00:00Z", Key: "1; DROP TABLE authors"},
+	} {
+		gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+		repo := repository.NewAuthorRepository(gdb)
+
+		page, err := repo.FindAll(t.Context(), port.ListQuery{Limit: 2, Cursor: &cursor})
+
+		assert.Nil(t, page, name)
+		assert.ErrorIs(t, err, model.ErrInvalidCursor, name)
+		assert.NoError(t, sqlMock.ExpectationsWereMet(), name)
+	}
+}
+
+func TestGivenUnknownSortWhenFindAllThenReturnsInvalidSort(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+
+	page, err := repo.FindAll(t.Context(), port.ListQuery{Sort: "bio"})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, model.ErrInvalidSort)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenTooLongBioWhenSaveThenReturnsValidationError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	author := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "authors"`).
+		WillReturnError(&pgconn.PgError{Code: "22001"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), author)
+
+	assert.Equal(t, domainerror.Validation, domainerror.KindOf(err))
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 }
 
@@ -80,10 +170,10 @@ func TestGivenDBErrorWhenFindAllThenReturnsError(t *testing.T) {
 	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
 	repo := repository.NewAuthorRepository(gdb)
 	expectedErr := errors.New(errSelectFailed)
-	sqlMock.ExpectQuery(authorsSelectAllRegex).
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "authors"`).
 		WillReturnError(expectedErr)
 
-	authors, err := repo.FindAll(t.Context())
+	authors, err := repo.FindAll(t.Context(), port.ListQuery{})
 
 	assert.Error(t, err)
 	assert.Nil(t, authors)
@@ -124,29 +214,67 @@ func TestGivenUnknownAuthorIDWhenFindByIdThenReturnsError(t *testing.T) {
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 }
 
-func TestGivenValidPatchWhenUpdateThenReturnsNilError(t *testing.T) {
+func TestGivenValidPatchWhenUpdateThenReturnsPersistedAuthor(t *testing.T) {
 	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
 	repo := repository.NewAuthorRepository(gdb)
 	original := builder.NewAuthorBuilder().Build()
 	patch := builder.UpdateAuthorBuilder().Build()
 	sqlMock.ExpectBegin()
-	sqlMock.ExpectExec(authorUpdateRegex).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectQuery(authorUpdateRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "bio"}).
+			AddRow(original.ID, patch.FirstName, patch.LastName, patch.Bio))
 	sqlMock.ExpectCommit()
 
 	got, err := repo.Update(t.Context(), original.ID, patch)
 
 	assert.NoError(t, err)
+	assert.Equal(t, original.ID, got.ID)
+	assert.Equal(t, patch.Bio, got.Bio)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownAuthorWhenUpdateThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	patch := builder.UpdateAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(authorUpdateRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Update(t.Context(), uuid.New(), patch)
+
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
 	assert.Nil(t, got)
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 }
 
+func TestGivenEmptyBioWhenPatchThenClearsBio(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	bio := ""
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "authors" SET "bio"=\$1,"updated_at"=\$2 WHERE id = \$3 AND "authors"\."deleted_at" IS NULL RETURNING \*`).
+		WithArgs("", sqlmock.AnyArg(), a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "bio"}).
+			AddRow(a.ID, a.FirstName, a.LastName, ""))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Patch(t.Context(), a.ID, &model.AuthorPatch{Bio: &bio})
+
+	assert.NoError(t, err)
+	assert.Empty(t, got.Bio)
+	assert.Equal(t, a.FirstName, got.FirstName)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
 func TestGivenDBErrorWhenUpdateThenReturnsError(t *testing.T) {
 	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
 	repo := repository.NewAuthorRepository(gdb)
 	orig := builder.NewAuthorBuilder().Build()
 	sqlMock.ExpectBegin()
-	sqlMock.ExpectExec(authorUpdateRegex).
+	sqlMock.ExpectQuery(authorUpdateRegex).
 		WillReturnError(errors.New(errUpdateFailed))
 	sqlMock.ExpectRollback()
 
@@ -156,3 +284,109 @@ func TestGivenDBErrorWhenUpdateThenReturnsError(t *testing.T) {
 	assert.Nil(t, got)
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 }
+
+func TestGivenUnlinkedAuthorWhenDeleteThenSoftDeletesAuthor(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(authorLockRegex).WithArgs(a.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name"}).AddRow(a.ID, a.FirstName, a.LastName))
+	sqlMock.ExpectQuery(authorLinksCountRegex).WithArgs(a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlMock.ExpectExec(authorSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), a.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Delete(t.Context(), a.ID, false)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenLinkedAuthorWhenDeleteWithoutForceThenReturnsHasBooks(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(authorLockRegex).WithArgs(a.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(a.ID))
+	sqlMock.ExpectQuery(authorLinksCountRegex).WithArgs(a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), a.ID, false)
+
+	assert.ErrorIs(t, err, model.ErrAuthorHasBooks)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenLinkedAuthorWhenDeleteWithForceThenSoftDeletesAuthorKeepingLinks(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(authorLockRegex).WithArgs(a.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(a.ID))
+	sqlMock.ExpectQuery(authorLinksCountRegex).WithArgs(a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	sqlMock.ExpectExec(authorSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), a.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Delete(t.Context(), a.ID, true)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownAuthorWhenDeleteThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	id := builder.NewAuthorBuilder().Build().ID
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(authorLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id, true)
+
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDeletedAuthorWhenRestoreThenReturnsAuthor(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "authors" SET "deleted_at"=\$1 WHERE id = \$2 RETURNING \*`).
+		WithArgs(nil, a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "deleted_at"}).
+			AddRow(a.ID, a.FirstName, a.LastName, nil))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Restore(t.Context(), a.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID, got.ID)
+	assert.False(t, got.DeletedAt.Valid)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenIncludeDeletedWhenFindAllThenQueriesWithoutDeletedFilter(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "authors"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "authors" ORDER BY "created_at","id" LIMIT \$1$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "deleted_at"}).
+			AddRow(a.ID, a.FirstName, a.LastName, a.CreatedAt))
+
+	got, err := repo.FindAll(port.WithDeleted(t.Context()), port.ListQuery{})
+
+	assert.NoError(t, err)
+	assert.True(t, got.Items[0].DeletedAt.Valid)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
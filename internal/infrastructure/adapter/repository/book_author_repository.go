@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// Links stay in place when their author or book is soft-deleted, so they come
+// back on restore; until then these conditions hide them.
+const (
+	linkToLiveAuthor = "EXISTS (SELECT 1 FROM authors WHERE authors.id = book_authors.author_id AND authors.deleted_at IS NULL)"
+	linkToLiveBook   = "EXISTS (SELECT 1 FROM books WHERE books.id = book_authors.book_id AND books.deleted_at IS NULL)"
+)
+
+type BookAuthorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBookAuthorRepository(db *gorm.DB) port.BookAuthorPort {
+	return &BookAuthorRepositoryImpl{db: db}
+}
+
+func (r *BookAuthorRepositoryImpl) Link(ctx context.Context, link *model.BookAuthor) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(link).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to link author to book", "error", err)
+		if isUniqueViolation(err, "") {
+			return model.ErrBookAuthorAlreadyLinked
+		}
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *BookAuthorRepositoryImpl) Unlink(ctx context.Context, bookID, authorID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("book_id = ? AND author_id = ?", bookID, authorID).
+		Delete(&model.BookAuthor{})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to unlink author from book", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrBookAuthorNotLinked
+	}
+	return nil
+}
+
+func (r *BookAuthorRepositoryImpl) FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error) {
+	var links []model.BookAuthor
+	err := r.db.WithContext(ctx).
+		Preload("Author").
+		Where("book_id = ? AND "+linkToLiveAuthor, bookID).
+		Order("position").
+		Find(&links).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find book authors", "error", err)
+		return nil, err
+	}
+	return links, nil
+}
+
+func (r *BookAuthorRepositoryImpl) FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error) {
+	var links []model.BookAuthor
+	err := r.db.WithContext(ctx).
+		Preload("Book").
+		Where("author_id = ? AND "+linkToLiveBook, authorID).
+		Find(&links).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find author books", "error", err)
+		return nil, err
+	}
+	return links, nil
+}
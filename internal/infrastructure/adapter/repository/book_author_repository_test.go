@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const (
+	bookAuthorInsertRegex    = `^INSERT INTO "book_authors"`
+	bookAuthorDeleteRegex    = `^DELETE FROM "book_authors" WHERE book_id = \$1 AND author_id = \$2`
+	bookAuthorsByBookRegex   = `^SELECT \* FROM "book_authors" WHERE book_id = \$1 AND EXISTS \(SELECT 1 FROM authors .*\) ORDER BY position`
+	bookAuthorsByAuthorRegex = `^SELECT \* FROM "book_authors" WHERE author_id = \$1 AND EXISTS \(SELECT 1 FROM books .*\)`
+	authorsPreloadByIDRegex  = `^SELECT \* FROM "authors" WHERE "authors"\."id" = \$1`
+	booksPreloadByIDRegex    = `^SELECT \* FROM "books" WHERE "books"\."id" = \$1`
+	bookAuthorPrimaryKey     = "pk_book_authors"
+)
+
+var bookAuthorColumns = []string{"book_id", "author_id", "role", "position"}
+
+func TestGivenNewLinkWhenLinkThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookAuthorRepository(gdb)
+	link := builder.NewBookAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(bookAuthorInsertRegex).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Link(t.Context(), link)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenExistingLinkWhenLinkThenReturnsAlreadyLinked(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookAuthorRepository(gdb)
+	link := builder.NewBookAuthorBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(bookAuthorInsertRegex).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: bookAuthorPrimaryKey})
+	sqlMock.ExpectRollback()
+
+	err := repo.Link(t.Context(), link)
+
+	assert.ErrorIs(t, err, model.ErrBookAuthorAlreadyLinked)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMissingLinkWhenUnlinkThenReturnsNotLinked(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookAuthorRepository(gdb)
+	bookID, authorID := uuid.New(), uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(bookAuthorDeleteRegex).WithArgs(bookID, authorID).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	err := repo.Unlink(t.Context(), bookID, authorID)
+
+	assert.ErrorIs(t, err, model.ErrBookAuthorNotLinked)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenBookWithAuthorsWhenFindByBookThenPreloadsAuthors(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookAuthorRepository(gdb)
+	a := builder.NewAuthorBuilder().Build()
+	link := builder.NewBookAuthorBuilder().WithAuthorID(a.ID).WithRole(model.BookAuthorRoleEditor).Build()
+	sqlMock.ExpectQuery(bookAuthorsByBookRegex).
+		WithArgs(link.BookID).
+		WillReturnRows(sqlmock.NewRows(bookAuthorColumns).AddRow(link.BookID, link.AuthorID, link.Role, link.Position))
+	sqlMock.ExpectQuery(authorsPreloadByIDRegex).
+		WithArgs(a.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "bio", "created_at", "updated_at"}).
+			AddRow(a.ID, a.FirstName, a.LastName, a.Bio, a.CreatedAt, a.UpdatedAt))
+
+	links, err := repo.FindByBook(t.Context(), link.BookID)
+
+	assert.NoError(t, err)
+	assert.Len(t, links, 1)
+	assert.Equal(t, model.BookAuthorRoleEditor, links[0].Role)
+	assert.Equal(t, a.FirstName, links[0].Author.FirstName)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenAuthorWithBooksWhenFindByAuthorThenPreloadsBooks(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookAuthorRepository(gdb)
+	b := builder.NewBookBuilder().Build()
+	link := builder.NewBookAuthorBuilder().WithBookID(b.ID).Build()
+	sqlMock.ExpectQuery(bookAuthorsByAuthorRegex).
+		WithArgs(link.AuthorID).
+		WillReturnRows(sqlmock.NewRows(bookAuthorColumns).AddRow(link.BookID, link.AuthorID, link.Role, link.Position))
+	sqlMock.ExpectQuery(booksPreloadByIDRegex).
+		WithArgs(b.ID).
+		WillReturnRows(sqlmock.NewRows(bookColumns).
+			AddRow(b.ID, b.Title, b.ISBN, b.Description, b.PublishedYear, b.CreatedAt, b.UpdatedAt))
+
+	links, err := repo.FindByAuthor(t.Context(), link.AuthorID)
+
+	assert.NoError(t, err)
+	assert.Len(t, links, 1)
+	assert.Equal(t, b.Title, links[0].Book.Title)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+const (
+	// copyInCirculation matches copies with a loan without returned_at or
+	// waiting on the hold shelf.
+	copyInCirculation = `EXISTS (` +
+		`SELECT 1 FROM loans WHERE loans.copy_id = book_copies.id AND loans.returned_at IS NULL) OR EXISTS (` +
+		`SELECT 1 FROM reservations WHERE reservations.held_copy_id = book_copies.id AND ` + activeReservation + `)`
+	// copyAvailabilitySelect derives BookCopy.Available: a copy can be lent
+	// when it is active and not in circulation.
+	copyAvailabilitySelect = `book_copies.*, book_copies.is_active AND NOT (` + copyInCirculation + `) AS available`
+)
+
+type BookCopyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBookCopyRepository(db *gorm.DB) port.BookCopyPort {
+	return &BookCopyRepositoryImpl{db: db}
+}
+
+func (r *BookCopyRepositoryImpl) Save(ctx context.Context, bookCopy *model.BookCopy) error {
+	err := dbFrom(ctx, r.db).Omit(clause.Associations).Create(bookCopy).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save book copy", "error", err)
+		if isUniqueViolation(err, "") {
+			return model.ErrCopyBarcodeConflict
+		}
+		return translateError(err)
+	}
+	bookCopy.Available = bookCopy.IsActive
+	slog.InfoContext(ctx, "Book copy save successful", "copy_id", bookCopy.ID)
+	return nil
+}
+
+func (r *BookCopyRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return findBookCopy(readFrom(ctx, r.db), "book_copies.id = ?", id)
+}
+
+// FindByIdForUpdate loads a copy and locks its row until the surrounding
+// transaction ends, serializing concurrent checkouts of the same copy.
+func (r *BookCopyRepositoryImpl) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	return findBookCopy(dbFrom(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), "book_copies.id = ?", id)
+}
+
+func (r *BookCopyRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (*model.BookCopy, error) {
+	return findBookCopy(readFrom(ctx, r.db), "book_copies.barcode = ?", barcode)
+}
+
+func (r *BookCopyRepositoryImpl) FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error) {
+	var copies []model.BookCopy
+	err := readFrom(ctx, r.db).
+		Select(copyAvailabilitySelect).
+		Where("book_copies.book_id = ?", bookID).
+		Order("book_copies.created_at").
+		Find(&copies).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find book copies", "error", err)
+		return nil, err
+	}
+	return copies, nil
+}
+
+func (r *BookCopyRepositoryImpl) SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.BookCopy, error) {
+	result := dbFrom(ctx, r.db).Model(&model.BookCopy{}).Where("id = ?", id).Update("is_active", active)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update book copy", "error", result.Error)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrCopyNotFound
+	}
+	return r.FindById(ctx, id)
+}
+
+// Delete locks the copy so no checkout can start while it is being deleted.
+func (r *BookCopyRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var bookCopy model.BookCopy
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&bookCopy).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrCopyNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var busy int64
+		if err := tx.Model(&model.BookCopy{}).Where("id = ? AND ("+copyInCirculation+")", id).Count(&busy).Error; err != nil {
+			return err
+		}
+		if busy > 0 {
+			return model.ErrCopyInCirculation
+		}
+		return tx.Delete(&bookCopy).Error
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete book copy", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Book copy delete successful", "copy_id", id)
+	return nil
+}
+
+func (r *BookCopyRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var bookCopy model.BookCopy
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&bookCopy).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrCopyNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if !bookCopy.DeletedAt.Valid {
+			return nil
+		}
+
+		var liveBooks int64
+		err = tx.Model(&model.Book{}).Where("id = ?", bookCopy.BookID).Count(&liveBooks).Error
+		if err != nil {
+			return err
+		}
+		if liveBooks == 0 {
+			return model.ErrBookNotFound
+		}
+		err = tx.Unscoped().Model(&bookCopy).UpdateColumn("deleted_at", nil).Error
+		if isUniqueViolation(err, "") {
+			return model.ErrCopyBarcodeConflict
+		}
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to restore book copy", "error", err)
+		return nil, err
+	}
+	return r.FindById(ctx, id)
+}
+
+func findBookCopy(db *gorm.DB, query string, arg any) (*model.BookCopy, error) {
+	var bookCopy model.BookCopy
+	err := db.
+		Select(copyAvailabilitySelect).
+		Where(query, arg).
+		Take(&bookCopy).Error
+	if err != nil {
+		slog.ErrorContext(db.Statement.Context, "Failed to find book copy", "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrCopyNotFound
+		}
+		return nil, err
+	}
+	return &bookCopy, nil
+}
@@ -0,0 +1,247 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const (
+	bookCopyInsertRegex       = `^INSERT INTO "book_copies"`
+	bookCopySelectByIDRegex   = `^SELECT book_copies\.\*, .* AS available FROM "book_copies" WHERE book_copies\.id = \$1`
+	bookCopyByBarcodeRegex    = `^SELECT book_copies\.\*, .* AS available FROM "book_copies" WHERE book_copies\.barcode = \$1`
+	bookCopiesByBookRegex     = `^SELECT book_copies\.\*, .* AS available FROM "book_copies" WHERE book_copies\.book_id = \$1 AND "book_copies"\."deleted_at" IS NULL ORDER BY book_copies\.created_at`
+	bookCopySetActiveRegex    = `^UPDATE "book_copies" SET "is_active"=\$1 WHERE id = \$2`
+	bookCopyBarcodeUniqueKey  = "uniq_live_copy_barcode"
+	bookCopyLockRegex         = `^SELECT \* FROM "book_copies" WHERE id = \$1 AND "book_copies"\."deleted_at" IS NULL LIMIT \$2 FOR UPDATE`
+	bookCopyUnscopedLockRegex = `^SELECT \* FROM "book_copies" WHERE id = \$1 LIMIT \$2 FOR UPDATE`
+	bookCopyCirculationRegex  = `^SELECT count\(\*\) FROM "book_copies" WHERE \(id = \$1 AND \(EXISTS .*\)\) AND "book_copies"\."deleted_at" IS NULL`
+	liveBookCountRegex        = `^SELECT count\(\*\) FROM "books" WHERE id = \$1 AND "books"\."deleted_at" IS NULL`
+)
+
+var bookCopyColumns = []string{"id", "book_id", "barcode", "condition", "is_active", "created_at", "available"}
+
+func bookCopyRow(c *model.BookCopy) *sqlmock.Rows {
+	return sqlmock.NewRows(bookCopyColumns).
+		AddRow(c.ID, c.BookID, c.Barcode, c.Condition, c.IsActive, c.CreatedAt, c.Available)
+}
+
+func TestGivenValidCopyWhenSaveThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyInsertRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookCopy.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), bookCopy)
+
+	assert.NoError(t, err)
+	assert.True(t, bookCopy.Available)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDuplicatedBarcodeWhenSaveThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyInsertRegex).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: bookCopyBarcodeUniqueKey})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), bookCopy)
+
+	assert.ErrorIs(t, err, model.ErrCopyBarcodeConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOnLoanWhenFindByIdThenReturnsUnavailable(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	sqlMock.ExpectQuery(bookCopySelectByIDRegex).
+		WithArgs(bookCopy.ID, sqlmock.AnyArg()).
+		WillReturnRows(bookCopyRow(bookCopy))
+
+	got, err := repo.FindById(t.Context(), bookCopy.ID)
+
+	assert.NoError(t, err)
+	assert.True(t, got.IsActive)
+	assert.False(t, got.Available)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownBarcodeWhenFindByBarcodeThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	sqlMock.ExpectQuery(bookCopyByBarcodeRegex).
+		WithArgs("missing", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(bookCopyColumns))
+
+	got, err := repo.FindByBarcode(t.Context(), "missing")
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrCopyNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenBookWithCopiesWhenFindByBookThenReturnsCopies(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookID := uuid.New()
+	c1 := builder.NewBookCopyBuilder().WithBookID(bookID).Build()
+	c2 := builder.NewBookCopyBuilder().WithBookID(bookID).WithAvailable(false).Build()
+	sqlMock.ExpectQuery(bookCopiesByBookRegex).
+		WithArgs(bookID).
+		WillReturnRows(sqlmock.NewRows(bookCopyColumns).
+			AddRow(c1.ID, c1.BookID, c1.Barcode, c1.Condition, c1.IsActive, c1.CreatedAt, c1.Available).
+			AddRow(c2.ID, c2.BookID, c2.Barcode, c2.Condition, c2.IsActive, c2.CreatedAt, c2.Available))
+
+	copies, err := repo.FindByBook(t.Context(), bookID)
+
+	assert.NoError(t, err)
+	assert.Len(t, copies, 2)
+	assert.True(t, copies[0].Available)
+	assert.False(t, copies[1].Available)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyWhenSetActiveThenReturnsReloadedCopy(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().WithActive(false).WithAvailable(false).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(bookCopySetActiveRegex).
+		WithArgs(false, bookCopy.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(bookCopySelectByIDRegex).
+		WithArgs(bookCopy.ID, sqlmock.AnyArg()).
+		WillReturnRows(bookCopyRow(bookCopy))
+
+	got, err := repo.SetActive(t.Context(), bookCopy.ID, false)
+
+	assert.NoError(t, err)
+	assert.False(t, got.IsActive)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownCopyWhenSetActiveThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(bookCopySetActiveRegex).
+		WithArgs(true, id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.SetActive(t.Context(), id, true)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrCopyNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOnLoanWhenDeleteThenReturnsInCirculation(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectQuery(bookCopyCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrCopyInCirculation)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOnShelfWhenDeleteThenSoftDeletesCopy(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectQuery(bookCopyCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlMock.ExpectExec(`^UPDATE "book_copies" SET "deleted_at"=\$1 WHERE "book_copies"\."id" = \$2 AND "book_copies"\."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownCopyWhenDeleteThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrCopyNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDeletedCopyWhenRestoreThenReturnsCopy(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyUnscopedLockRegex).WithArgs(bookCopy.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "book_id", "deleted_at"}).
+			AddRow(bookCopy.ID, bookCopy.BookID, bookCopy.CreatedAt))
+	sqlMock.ExpectQuery(liveBookCountRegex).WithArgs(bookCopy.BookID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectExec(`^UPDATE "book_copies" SET "deleted_at"=\$1 WHERE "id" = \$2$`).WithArgs(nil, bookCopy.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(bookCopySelectByIDRegex).WithArgs(bookCopy.ID, 1).
+		WillReturnRows(bookCopyRow(bookCopy))
+
+	got, err := repo.Restore(t.Context(), bookCopy.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bookCopy.ID, got.ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOfDeletedBookWhenRestoreThenReturnsBookNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopyUnscopedLockRegex).WithArgs(bookCopy.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "book_id", "deleted_at"}).
+			AddRow(bookCopy.ID, bookCopy.BookID, bookCopy.CreatedAt))
+	sqlMock.ExpectQuery(liveBookCountRegex).WithArgs(bookCopy.BookID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlMock.ExpectRollback()
+
+	_, err := repo.Restore(t.Context(), bookCopy.ID)
+
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
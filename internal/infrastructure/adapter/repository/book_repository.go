@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// bookInCirculation matches books with a copy on loan or an active reservation.
+const bookInCirculation = `EXISTS (SELECT 1 FROM loans JOIN book_copies ON book_copies.id = loans.copy_id ` +
+	`WHERE book_copies.book_id = books.id AND loans.returned_at IS NULL) OR EXISTS (` +
+	`SELECT 1 FROM reservations WHERE reservations.book_id = books.id AND ` + activeReservation + `)`
+
+type BookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBookRepository(db *gorm.DB) port.BookPort {
+	return &BookRepositoryImpl{db: db}
+}
+
+func (r *BookRepositoryImpl) Save(ctx context.Context, book *model.Book) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(book).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save book", "error", err)
+		return translateBookError(err)
+	}
+	slog.InfoContext(ctx, "Book save successful", "book_id", book.ID)
+	return nil
+}
+
+var bookList = listSpec[model.Book]{
+	key:         "id",
+	keyCast:     "uuid",
+	keyOf:       func(b *model.Book) string { return b.ID.String() },
+	defaultSort: "created_at",
+	sorts: map[string]sortColumn[model.Book]{
+		"created_at":     {cast: "timestamptz", value: func(b *model.Book) string { return timeValue(b.CreatedAt) }},
+		"title":          {cast: "text", value: func(b *model.Book) string { return b.Title }},
+		"published_year": {cast: "integer", value: func(b *model.Book) string { return strconv.Itoa(b.PublishedYear) }},
+	},
+	filters: map[string]listFilter{
+		"title":          prefixFilter("title"),
+		"isbn":           equalFilter("isbn"),
+		"published_year": intFilter("published_year"),
+	},
+}
+
+func (r *BookRepositoryImpl) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error) {
+	page, err := findPage(readFrom(ctx, r.db), query, bookList)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find books", "error", err)
+		return nil, translateBookError(err)
+	}
+	return page, nil
+}
+
+func (r *BookRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	var book model.Book
+	err := readFrom(ctx, r.db).First(&book, "id = ?", id).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find book", "error", err)
+		return nil, translateBookError(err)
+	}
+	return &book, nil
+}
+
+func (r *BookRepositoryImpl) Update(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error) {
+	var book model.Book
+	result := r.db.WithContext(ctx).Model(&book).
+		Clauses(clause.Returning{}).
+		Omit(clause.Associations).
+		Where("id = ?", id).
+		Updates(patch)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update book", "error", result.Error)
+		return nil, translateBookError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrBookNotFound
+	}
+	return &book, nil
+}
+
+// Delete stamps the book and its copies with the same deleted_at. The copies
+// are stamped before the circulation check so that a checkout racing with the
+// delete either commits first and is seen, or finds the copy gone.
+func (r *BookRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var book model.Book
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&book).Error; err != nil {
+			return translateBookError(err)
+		}
+
+		now := tx.NowFunc()
+		if err := tx.Model(&model.BookCopy{}).Where("book_id = ?", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+		var busy int64
+		if err := tx.Model(&model.Book{}).Where("id = ? AND ("+bookInCirculation+")", id).Count(&busy).Error; err != nil {
+			return err
+		}
+		if busy > 0 {
+			return model.ErrBookInCirculation
+		}
+		return tx.Model(&book).UpdateColumn("deleted_at", now).Error
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete book", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Book delete successful", "book_id", id)
+	return nil
+}
+
+// Restore clears deleted_at on the book and on the copies deleted with it.
+// Copies deleted on their own before the book stay deleted. Restoring a book
+// that is not deleted returns it unchanged.
+func (r *BookRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	var book model.Book
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&book).Error; err != nil {
+			return translateBookError(err)
+		}
+		if !book.DeletedAt.Valid {
+			return nil
+		}
+
+		err := tx.Unscoped().Model(&model.BookCopy{}).
+			Where("book_id = ? AND deleted_at = ?", id, book.DeletedAt.Time).
+			UpdateColumn("deleted_at", nil).Error
+		if isUniqueViolation(err, "") {
+			return model.ErrCopyBarcodeConflict
+		}
+		if err != nil {
+			return err
+		}
+		book.DeletedAt = gorm.DeletedAt{}
+		return translateBookError(tx.Unscoped().Model(&book).UpdateColumn("deleted_at", nil).Error)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to restore book", "error", err)
+		return nil, err
+	}
+	return &book, nil
+}
+
+func translateBookError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return model.ErrBookNotFound
+	case isUniqueViolation(err, ""):
+		return model.ErrBookISBNConflict
+	default:
+		return translateError(err)
+	}
+}
@@ -0,0 +1,290 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const (
+	bookInsertRegex           = `^INSERT INTO "books"`
+	booksSelectAllRegex       = `^SELECT .* FROM "books"`
+	bookSelectByIDRegex       = `^SELECT .* FROM "books" WHERE id = \$1`
+	bookUpdateRegex           = `^UPDATE "books" SET .* WHERE id = \$\d+ AND "books"\."deleted_at" IS NULL RETURNING \*`
+	bookLockRegex             = `^SELECT \* FROM "books" WHERE id = \$1 AND "books"\."deleted_at" IS NULL LIMIT \$2 FOR UPDATE`
+	bookUnscopedLockRegex     = `^SELECT \* FROM "books" WHERE id = \$1 LIMIT \$2 FOR UPDATE`
+	bookSoftDeleteRegex       = `^UPDATE "books" SET "deleted_at"=\$1 WHERE "books"\."deleted_at" IS NULL AND "id" = \$2`
+	bookCirculationRegex      = `^SELECT count\(\*\) FROM "books" WHERE \(id = \$1 AND \(EXISTS .*\)\) AND "books"\."deleted_at" IS NULL`
+	bookCopiesSoftDeleteRegex = `^UPDATE "book_copies" SET "deleted_at"=\$1 WHERE book_id = \$2 AND "book_copies"\."deleted_at" IS NULL`
+)
+
+var bookColumns = []string{"id", "title", "isbn", "description", "published_year", "created_at", "updated_at"}
+
+func TestGivenValidBookWhenSaveThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	book := builder.NewBookBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookInsertRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(book.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), book)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDuplicatedISBNWhenSaveThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	book := builder.NewBookBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookInsertRegex).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "books_isbn_key"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), book)
+
+	assert.ErrorIs(t, err, model.ErrBookISBNConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenYearFilterWhenFindAllThenReturnsFilteredPage(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	b1 := builder.NewBookBuilder().Build()
+	b2 := builder.NewBookBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "books" WHERE published_year = \$1`).
+		WithArgs(1967).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "books" WHERE published_year = \$1 AND "books"."deleted_at" IS NULL `+
+		`ORDER BY "title" DESC,"id" DESC LIMIT \$2$`).
+		WithArgs(1967, port.DefaultListLimit+1).
+		WillReturnRows(sqlmock.NewRows(bookColumns).
+			AddRow(b1.ID, b1.Title, b1.ISBN, b1.Description, b1.PublishedYear, b1.CreatedAt, b1.UpdatedAt).
+			AddRow(b2.ID, b2.Title, b2.ISBN, b2.Description, b2.PublishedYear, b2.CreatedAt, b2.UpdatedAt))
+
+	books, err := repo.FindAll(t.Context(), port.ListQuery{
+		Sort:    "title",
+		Desc:    true,
+		Filters: map[string]string{"published_year": "1967"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, books.Items, 2)
+	assert.Equal(t, int64(2), books.Total)
+	assert.Nil(t, books.Next)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCursorOnBookWithoutYearWhenFindAllThenReadsOnFromIt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	key := uuid.New()
+	b1 := builder.NewBookBuilder().WithPublishedYear(0).Build()
+	b2 := builder.NewBookBuilder().WithPublishedYear(1965).Build()
+	b3 := builder.NewBookBuilder().WithPublishedYear(1969).Build()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "books"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "books" WHERE \(published_year, id\) > \(CAST\(\$1 AS integer\), \$2\) `+
+		`AND "books"."deleted_at" IS NULL ORDER BY "published_year","id" LIMIT \$3$`).
+		WithArgs(0, key, 3).
+		WillReturnRows(sqlmock.NewRows(bookColumns).
+			AddRow(b1.ID, b1.Title, b1.ISBN, b1.Description, b1.PublishedYear, b1.CreatedAt, b1.UpdatedAt).
+			AddRow(b2.ID, b2.Title, b2.ISBN, b2.Description, b2.PublishedYear, b2.CreatedAt, b2.UpdatedAt).
+			AddRow(b3.ID, b3.Title, b3.ISBN, b3.Description, b3.PublishedYear, b3.CreatedAt, b3.UpdatedAt))
+
+	books, err := repo.FindAll(t.Context(), port.ListQuery{
+		Limit:  2,
+		Sort:   "published_year",
+		Cursor: &port.Cursor{Value: "0", Key: key.String()},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, books.Items, 2)
+	assert.Equal(t, &port.Cursor{Value: "1965", Key: b2.ID.String()}, books.Next)
+	assert.Equal(t, &port.Cursor{Value: "0", Key: b1.ID.String(), Before: true}, books.Prev)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenNonNumericYearWhenFindAllThenReturnsInvalidFilter(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+
+	books, err := repo.FindAll(t.Context(), port.ListQuery{Filters: map[string]string{"published_year": "sixties"}})
+
+	assert.Nil(t, books)
+	assert.ErrorIs(t, err, model.ErrInvalidFilter)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownBookIDWhenFindByIdThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectQuery(bookSelectByIDRegex).
+		WithArgs(id, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(bookColumns))
+
+	got, err := repo.FindById(t.Context(), id)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenValidPatchWhenUpdateBookThenReturnsPersistedBook(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	b := builder.NewBookBuilder().WithTitle("New title").Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookUpdateRegex).
+		WillReturnRows(sqlmock.NewRows(bookColumns).
+			AddRow(b.ID, b.Title, b.ISBN, b.Description, b.PublishedYear, b.CreatedAt, b.UpdatedAt))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Update(t.Context(), b.ID, &model.Book{Title: "New title"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.ID, got.ID)
+	assert.Equal(t, "New title", got.Title)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownBookWhenUpdateThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookUpdateRegex).WillReturnRows(sqlmock.NewRows(bookColumns))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Update(t.Context(), uuid.New(), &model.Book{Title: "x"})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenBookOffShelfWhenDeleteThenSoftDeletesBookAndCopies(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectExec(bookCopiesSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectQuery(bookCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlMock.ExpectExec(bookSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenBookInCirculationWhenDeleteThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectExec(bookCopiesSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectQuery(bookCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrBookInCirculation)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownBookWhenDeleteThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrBookNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDBErrorWhenDeleteBookThenReturnsError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectExec(bookCopiesSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), id).
+		WillReturnError(errors.New("delete failed"))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.EqualError(t, err, "delete failed")
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDeletedBookWhenRestoreThenRestoresBookAndItsCopies(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	deletedAt := time.Now()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookUnscopedLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).AddRow(id, "Dune", deletedAt))
+	sqlMock.ExpectExec(`^UPDATE "book_copies" SET "deleted_at"=\$1 WHERE book_id = \$2 AND deleted_at = \$3$`).
+		WithArgs(nil, id, deletedAt).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(`^UPDATE "books" SET "deleted_at"=\$1 WHERE "id" = \$2$`).WithArgs(nil, id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	book, err := repo.Restore(t.Context(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, book.ID)
+	assert.False(t, book.DeletedAt.Valid)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyBarcodeTakenWhenRestoreBookThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewBookRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookUnscopedLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at"}).AddRow(id, time.Now()))
+	sqlMock.ExpectExec(`^UPDATE "book_copies" SET "deleted_at"=\$1`).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	sqlMock.ExpectRollback()
+
+	_, err := repo.Restore(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrCopyBarcodeConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
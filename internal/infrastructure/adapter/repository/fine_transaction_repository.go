@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type FineTransactionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFineTransactionRepository(db *gorm.DB) port.FineTransactionPort {
+	return &FineTransactionRepositoryImpl{db: db}
+}
+
+func (r *FineTransactionRepositoryImpl) Save(ctx context.Context, transaction *model.FineTransaction) error {
+	err := dbFrom(ctx, r.db).Omit(clause.Associations).Create(transaction).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save fine transaction", "error", err)
+		return translateError(err)
+	}
+	slog.InfoContext(ctx, "Fine transaction save successful", "fine_transaction_id", transaction.ID)
+	return nil
+}
+
+func (r *FineTransactionRepositoryImpl) FindByLoan(ctx context.Context, loanID uuid.UUID) ([]model.FineTransaction, error) {
+	return r.find(ctx, "loan_id = ?", loanID)
+}
+
+func (r *FineTransactionRepositoryImpl) FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.FineTransaction, error) {
+	return r.find(ctx, "member_id = ?", memberID)
+}
+
+func (r *FineTransactionRepositoryImpl) find(ctx context.Context, query string, arg any) ([]model.FineTransaction, error) {
+	var transactions []model.FineTransaction
+	err := dbFrom(ctx, r.db).Where(query, arg).Order("created_at").Find(&transactions).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find fine transactions", "error", err)
+		return nil, err
+	}
+	return transactions, nil
+}
@@ -0,0 +1,76 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+var fineTransactionColumns = []string{"id", "loan_id", "member_id", "kind", "amount_cents", "note", "created_at"}
+
+func TestGivenPaymentWhenSaveFineTransactionThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewFineTransactionRepository(gdb)
+	transaction := builder.NewFineTransactionBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "fine_transactions"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(transaction.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), transaction)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDatabaseErrorWhenSaveFineTransactionThenReturnsError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewFineTransactionRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "fine_transactions"`).WillReturnError(errors.New("insert failed"))
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), builder.NewFineTransactionBuilder().Build())
+
+	assert.Error(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenLoanWithTransactionsWhenFindByLoanThenReturnsThem(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewFineTransactionRepository(gdb)
+	tr := builder.NewFineTransactionBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "fine_transactions" WHERE loan_id = \$1 ORDER BY created_at`).
+		WithArgs(tr.LoanID).
+		WillReturnRows(sqlmock.NewRows(fineTransactionColumns).
+			AddRow(tr.ID, tr.LoanID, tr.MemberID, tr.Kind, tr.AmountCents, tr.Note, tr.CreatedAt))
+
+	got, err := repo.FindByLoan(t.Context(), tr.LoanID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, tr.AmountCents, got[0].AmountCents)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithTransactionsWhenFindByMemberThenReturnsThem(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewFineTransactionRepository(gdb)
+	tr := builder.NewFineTransactionBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "fine_transactions" WHERE member_id = \$1 ORDER BY created_at`).
+		WithArgs(tr.MemberID).
+		WillReturnRows(sqlmock.NewRows(fineTransactionColumns).
+			AddRow(tr.ID, tr.LoanID, tr.MemberID, tr.Kind, tr.AmountCents, tr.Note, tr.CreatedAt))
+
+	got, err := repo.FindByMember(t.Context(), tr.MemberID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
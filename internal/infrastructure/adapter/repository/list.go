@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// listSpec describes how a table is listed: the columns it may be sorted by,
+// the filters it accepts and the unique key, of SQL type keyCast, that orders
+// rows with the same sort value.
+type listSpec[T any] struct {
+	key         string
+	keyCast     string
+	keyOf       func(*T) string
+	defaultSort string
+	sorts       map[string]sortColumn[T]
+	filters     map[string]listFilter
+	preload     []string
+}
+
+// sortColumn is a sortable column. cast is its SQL type, used to compare it
+// with the value of a cursor, and value reads that value from a row.
+type sortColumn[T any] struct {
+	cast  string
+	value func(*T) string
+}
+
+type listFilter func(db *gorm.DB, value string) (*gorm.DB, error)
+
+// findPage reads the page of db selected by query. Rows are ordered by the
+// sort column and then by the key, so keyset cursors are stable under inserts.
+func findPage[T any](db *gorm.DB, query port.ListQuery, spec listSpec[T]) (*port.Page[T], error) {
+	sort := query.Sort
+	if sort == "" {
+		sort = spec.defaultSort
+	}
+	column, ok := spec.sorts[sort]
+	if !ok {
+		return nil, model.ErrInvalidSort
+	}
+	for _, name := range slices.Sorted(maps.Keys(query.Filters)) {
+		filter, ok := spec.filters[name]
+		if !ok {
+			return nil, model.ErrInvalidFilter
+		}
+		var err error
+		if db, err = filter(db, query.Filters[name]); err != nil {
+			return nil, err
+		}
+	}
+	var cursorValue, cursorKey any
+	if query.Cursor != nil {
+		var err error
+		if cursorValue, err = parseCursorValue(column.cast, query.Cursor.Value); err != nil {
+			return nil, err
+		}
+		if cursorKey, err = parseCursorValue(spec.keyCast, query.Cursor.Key); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Model(new(T)).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = port.DefaultListLimit
+	}
+	before := query.Cursor != nil && query.Cursor.Before
+	desc := query.Desc != before
+	order := []clause.OrderByColumn{{Column: clause.Column{Name: sort}, Desc: desc}}
+	if sort != spec.key {
+		order = append(order, clause.OrderByColumn{Column: clause.Column{Name: spec.key}, Desc: desc})
+	}
+	find := db.Order(clause.OrderBy{Columns: order})
+	for _, association := range spec.preload {
+		find = find.Preload(association)
+	}
+	switch {
+	case query.Cursor != nil:
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		condition, args := keyset(sort, spec.key, column.cast, op, cursorValue, cursorKey)
+		find = find.Where(condition, args...)
+	case query.Page > 1:
+		find = find.Offset((query.Page - 1) * limit)
+	}
+
+	var items []T
+	if err := find.Limit(limit + 1).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	more := len(items) > limit
+	if more {
+		items = items[:limit]
+	}
+	if before {
+		slices.Reverse(items)
+	}
+
+	page := &port.Page[T]{Items: items, Total: total}
+	if len(items) == 0 {
+		return page, nil
+	}
+	hasNext, hasPrev := more, query.Cursor != nil || query.Page > 1
+	if before {
+		hasNext, hasPrev = true, more
+	}
+	if hasNext {
+		last := &items[len(items)-1]
+		page.Next = &port.Cursor{Value: column.value(last), Key: spec.keyOf(last)}
+	}
+	if hasPrev {
+		first := &items[0]
+		page.Prev = &port.Cursor{Value: column.value(first), Key: spec.keyOf(first), Before: true}
+	}
+	return page, nil
+}
+
+// keyset returns the condition selecting the rows after the cursor value and
+// key in the order given by op: > when ascending, < when descending.
+func keyset(sort, key, cast, op string, value, keyValue any) (string, []any) {
+	if sort == key {
+		return fmt.Sprintf("%s %s CAST(? AS %s)", key, op, cast), []any{keyValue}
+	}
+	return fmt.Sprintf("(%s, %s) %s (CAST(? AS %s), ?)", sort, key, op, cast), []any{value, keyValue}
+}
+
+// parseCursorValue checks that a value taken from a cursor has the SQL type
+// cast, so that a tampered cursor is rejected with ErrInvalidCursor instead of
+// failing the query.
+func parseCursorValue(cast, value string) (any, error) {
+	var parsed any
+	var err error
+	switch cast {
+	case "integer":
+		var n int64
+		n, err = strconv.ParseInt(value, 10, 32)
+		parsed = int(n)
+	case "timestamptz":
+		parsed, err = time.Parse(time.RFC3339Nano, value)
+	case "uuid":
+		parsed, err = uuid.Parse(value)
+	default:
+		parsed = value
+	}
+	if err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+	return parsed, nil
+}
+
+// prefixFilter matches rows whose column starts with the value, ignoring case.
+func prefixFilter(column string) listFilter {
+	escape := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return func(db *gorm.DB, value string) (*gorm.DB, error) {
+		return db.Where(column+" ILIKE ?", escape.Replace(value)+"%"), nil
+	}
+}
+
+func equalFilter(column string) listFilter {
+	return func(db *gorm.DB, value string) (*gorm.DB, error) {
+		return db.Where(column+" = ?", value), nil
+	}
+}
+
+func intFilter(column string) listFilter {
+	return func(db *gorm.DB, value string) (*gorm.DB, error) {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, model.ErrInvalidFilter
+		}
+		return db.Where(column+" = ?", n), nil
+	}
+}
+
+func boolFilter(column string) listFilter {
+	return func(db *gorm.DB, value string) (*gorm.DB, error) {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, model.ErrInvalidFilter
+		}
+		return db.Where(column+" = ?", b), nil
+	}
+}
+
+func timeValue(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+const activeLoanPerCopyIndex = "uniq_active_loan_per_copy"
+
+type LoanRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoanRepository(db *gorm.DB) port.LoanPort {
+	return &LoanRepositoryImpl{db: db}
+}
+
+func (r *LoanRepositoryImpl) Save(ctx context.Context, loan *model.Loan) error {
+	err := dbFrom(ctx, r.db).Omit(clause.Associations).Create(loan).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save loan", "error", err)
+		if isUniqueViolation(err, activeLoanPerCopyIndex) {
+			return model.ErrCopyAlreadyOnLoan
+		}
+		return translateError(err)
+	}
+	slog.InfoContext(ctx, "Loan save successful", "loan_id", loan.ID)
+	return nil
+}
+
+func (r *LoanRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.Loan, error) {
+	return findLoan(dbFrom(ctx, r.db), id)
+}
+
+// FindByIdForUpdate locks the loan until the surrounding transaction ends.
+func (r *LoanRepositoryImpl) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Loan, error) {
+	return findLoan(dbFrom(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *LoanRepositoryImpl) FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error) {
+	var loans []model.Loan
+	err := dbFrom(ctx, r.db).
+		Where("member_id = ?", memberID).
+		Order("loaned_at DESC").
+		Find(&loans).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find member loans", "error", err)
+		return nil, err
+	}
+	return loans, nil
+}
+
+// FindActiveByMember returns the loans a member has not returned yet, soonest
+// due first.
+func (r *LoanRepositoryImpl) FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error) {
+	var loans []model.Loan
+	err := dbFrom(ctx, r.db).
+		Where("member_id = ? AND returned_at IS NULL", memberID).
+		Order("due_date").
+		Find(&loans).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find member active loans", "error", err)
+		return nil, err
+	}
+	return loans, nil
+}
+
+// CountActiveByMember counts the loans a member has not returned yet.
+func (r *LoanRepositoryImpl) CountActiveByMember(ctx context.Context, memberID uuid.UUID) (int, error) {
+	var count int64
+	err := dbFrom(ctx, r.db).Model(&model.Loan{}).
+		Where("member_id = ? AND returned_at IS NULL", memberID).
+		Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count member active loans", "error", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// CountOverdue counts the loans not returned yet that were due before dueBefore.
+func (r *LoanRepositoryImpl) CountOverdue(ctx context.Context, dueBefore time.Time) (int, error) {
+	var count int64
+	err := dbFrom(ctx, r.db).Model(&model.Loan{}).
+		Where("returned_at IS NULL AND due_date < ?", dueBefore).
+		Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count overdue loans", "error", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindActiveByCopy returns the open loan of a copy and locks it until the
+// surrounding transaction ends.
+func (r *LoanRepositoryImpl) FindActiveByCopy(ctx context.Context, copyID uuid.UUID) (*model.Loan, error) {
+	var loan model.Loan
+	err := dbFrom(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("copy_id = ? AND returned_at IS NULL", copyID).
+		Take(&loan).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find active loan", "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrCopyNotOnLoan
+		}
+		return nil, err
+	}
+	return &loan, nil
+}
+
+// Close persists the return of an open loan.
+func (r *LoanRepositoryImpl) Close(ctx context.Context, loan *model.Loan) error {
+	result := dbFrom(ctx, r.db).Model(&model.Loan{}).
+		Where("id = ? AND returned_at IS NULL", loan.ID).
+		Updates(map[string]any{"returned_at": loan.ReturnedAt, "fine_cents": loan.FineCents})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to close loan", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrCopyNotOnLoan
+	}
+	return nil
+}
+
+// Renew persists the new due date and renewal count of an open loan.
+func (r *LoanRepositoryImpl) Renew(ctx context.Context, loan *model.Loan) error {
+	result := dbFrom(ctx, r.db).Model(&model.Loan{}).
+		Where("id = ? AND returned_at IS NULL", loan.ID).
+		Updates(map[string]any{"due_date": loan.DueDate, "renewal_count": loan.RenewalCount})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to renew loan", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrLoanAlreadyReturned
+	}
+	return nil
+}
+
+func findLoan(db *gorm.DB, id uuid.UUID) (*model.Loan, error) {
+	var loan model.Loan
+	err := db.Where("id = ?", id).Take(&loan).Error
+	if err != nil {
+		slog.ErrorContext(db.Statement.Context, "Failed to find loan", "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrLoanNotFound
+		}
+		return nil, err
+	}
+	return &loan, nil
+}
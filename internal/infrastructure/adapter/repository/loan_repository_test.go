@@ -0,0 +1,254 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const (
+	loanInsertRegex       = `^INSERT INTO "loans"`
+	loanActiveByCopyRegex = `^SELECT \* FROM "loans" WHERE copy_id = \$1 AND returned_at IS NULL LIMIT \$2 FOR UPDATE`
+	loanCloseRegex        = `^UPDATE "loans" SET "fine_cents"=\$1,"returned_at"=\$2 WHERE id = \$3 AND returned_at IS NULL`
+)
+
+var loanColumns = []string{"id", "member_id", "copy_id", "loaned_at", "due_date", "returned_at", "fine_cents", "created_at"}
+
+func TestGivenValidLoanWhenSaveThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	loan := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(loanInsertRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(loan.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), loan)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenActiveLoanIndexViolationWhenSaveThenReturnsAlreadyOnLoan(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	loan := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(loanInsertRegex).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "uniq_active_loan_per_copy"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), loan)
+
+	assert.ErrorIs(t, err, model.ErrCopyAlreadyOnLoan)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOtherUniqueViolationWhenSaveThenReturnsRawError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	loan := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(loanInsertRegex).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "loans_pkey"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), loan)
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, model.ErrCopyAlreadyOnLoan)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOnLoanWhenFindActiveByCopyThenReturnsLockedLoan(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	l := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectQuery(loanActiveByCopyRegex).
+		WithArgs(l.CopyID, 1).
+		WillReturnRows(sqlmock.NewRows(loanColumns).
+			AddRow(l.ID, l.MemberID, l.CopyID, l.LoanedAt, l.DueDate, nil, l.FineCents, l.CreatedAt))
+
+	got, err := repo.FindActiveByCopy(t.Context(), l.CopyID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, l.ID, got.ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyWithoutLoanWhenFindActiveByCopyThenReturnsNotOnLoan(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	copyID := uuid.New()
+	sqlMock.ExpectQuery(loanActiveByCopyRegex).
+		WithArgs(copyID, 1).
+		WillReturnRows(sqlmock.NewRows(loanColumns))
+
+	got, err := repo.FindActiveByCopy(t.Context(), copyID)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrCopyNotOnLoan)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOpenLoanWhenCloseThenPersistsReturnedAt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	returnedAt := time.Now()
+	loan := builder.NewLoanBuilder().WithReturnedAt(returnedAt).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(loanCloseRegex).
+		WithArgs(0, returnedAt, loan.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Close(t.Context(), loan)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenAlreadyClosedLoanWhenCloseThenReturnsNotOnLoan(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	loan := builder.NewLoanBuilder().WithReturnedAt(time.Now()).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(loanCloseRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	err := repo.Close(t.Context(), loan)
+
+	assert.ErrorIs(t, err, model.ErrCopyNotOnLoan)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenExistingLoanWhenFindByIdThenReturnsLoan(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	l := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "loans" WHERE id = \$1 LIMIT \$2$`).
+		WithArgs(l.ID, 1).
+		WillReturnRows(sqlmock.NewRows(loanColumns).
+			AddRow(l.ID, l.MemberID, l.CopyID, l.LoanedAt, l.DueDate, nil, l.FineCents, l.CreatedAt))
+
+	got, err := repo.FindById(t.Context(), l.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, l.CopyID, got.CopyID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownLoanWhenFindByIdForUpdateThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "loans" WHERE id = \$1 LIMIT \$2 FOR UPDATE`).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows(loanColumns))
+
+	got, err := repo.FindByIdForUpdate(t.Context(), id)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrLoanNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithLoansWhenFindByMemberThenReturnsNewestFirst(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	l := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "loans" WHERE member_id = \$1 ORDER BY loaned_at DESC`).
+		WithArgs(l.MemberID).
+		WillReturnRows(sqlmock.NewRows(loanColumns).
+			AddRow(l.ID, l.MemberID, l.CopyID, l.LoanedAt, l.DueDate, nil, l.FineCents, l.CreatedAt))
+
+	got, err := repo.FindByMember(t.Context(), l.MemberID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithOpenLoansWhenFindActiveByMemberThenReturnsSoonestDueFirst(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	l := builder.NewLoanBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "loans" WHERE member_id = \$1 AND returned_at IS NULL ORDER BY due_date`).
+		WithArgs(l.MemberID).
+		WillReturnRows(sqlmock.NewRows(loanColumns).
+			AddRow(l.ID, l.MemberID, l.CopyID, l.LoanedAt, l.DueDate, nil, l.FineCents, l.CreatedAt))
+
+	got, err := repo.FindActiveByMember(t.Context(), l.MemberID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithOpenLoansWhenCountActiveByMemberThenReturnsCount(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	memberID := uuid.New()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "loans" WHERE member_id = \$1 AND returned_at IS NULL`).
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	got, err := repo.CountActiveByMember(t.Context(), memberID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOpenLoansWhenCountOverdueThenCountsThoseDueBefore(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	dueBefore := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "loans" WHERE returned_at IS NULL AND due_date < \$1`).
+		WithArgs(dueBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	got, err := repo.CountOverdue(t.Context(), dueBefore)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOpenLoanWhenRenewThenPersistsDueDateAndCount(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	loan := builder.NewLoanBuilder().WithRenewalCount(1).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "loans" SET "due_date"=\$1,"renewal_count"=\$2 WHERE id = \$3 AND returned_at IS NULL`).
+		WithArgs(loan.DueDate, 1, loan.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Renew(t.Context(), loan)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenReturnedLoanWhenRenewThenReturnsAlreadyReturned(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewLoanRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "loans"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	err := repo.Renew(t.Context(), builder.NewLoanBuilder().Build())
+
+	assert.ErrorIs(t, err, model.ErrLoanAlreadyReturned)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// memberInCirculation matches members with an open loan or an active
+// reservation.
+const memberInCirculation = `EXISTS (` +
+	`SELECT 1 FROM loans WHERE loans.member_id = members.id AND loans.returned_at IS NULL) OR EXISTS (` +
+	`SELECT 1 FROM reservations WHERE reservations.member_id = members.id AND ` + activeReservation + `)`
+
+type MemberRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMemberRepository(db *gorm.DB) port.MemberPort {
+	return &MemberRepositoryImpl{db: db}
+}
+
+func (r *MemberRepositoryImpl) Save(ctx context.Context, member *model.Member) error {
+	err := dbFrom(ctx, r.db).Omit(clause.Associations).Create(member).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save member", "error", err)
+		if isForeignKeyViolation(err) {
+			return model.ErrMembershipCategoryNotFound
+		}
+		return translateMemberError(err)
+	}
+	slog.InfoContext(ctx, "Member save successful", "member_id", member.ID)
+	return nil
+}
+
+var memberList = listSpec[model.Member]{
+	key:         "id",
+	keyCast:     "uuid",
+	keyOf:       func(m *model.Member) string { return m.ID.String() },
+	defaultSort: "full_name",
+	sorts: map[string]sortColumn[model.Member]{
+		"full_name":  {cast: "text", value: func(m *model.Member) string { return m.FullName }},
+		"email":      {cast: "text", value: func(m *model.Member) string { return m.Email }},
+		"created_at": {cast: "timestamptz", value: func(m *model.Member) string { return timeValue(m.CreatedAt) }},
+	},
+	filters: map[string]listFilter{
+		"full_name": prefixFilter("full_name"),
+		"email":     prefixFilter("email"),
+		"category":  equalFilter("category"),
+		"is_active": boolFilter("is_active"),
+	},
+	preload: []string{"MembershipCategory"},
+}
+
+func (r *MemberRepositoryImpl) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error) {
+	page, err := findPage(readFrom(ctx, r.db), query, memberList)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find members", "error", err)
+		return nil, translateMemberError(err)
+	}
+	return page, nil
+}
+
+func (r *MemberRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return findMember(readFrom(ctx, r.db), "id = ?", id)
+}
+
+// FindByIdForUpdate loads a member and locks its row until the surrounding
+// transaction ends, serializing concurrent checkouts by the same member.
+func (r *MemberRepositoryImpl) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	return findMember(dbFrom(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "members"}}),
+		"id = ?", id)
+}
+
+func (r *MemberRepositoryImpl) FindByEmail(ctx context.Context, email string) (*model.Member, error) {
+	return findMember(readFrom(ctx, r.db), "email = ?", email)
+}
+
+// Update applies the non-zero fields of patch. is_active is left alone, it
+// only changes through SetActive.
+func (r *MemberRepositoryImpl) Update(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error) {
+	var member model.Member
+	result := dbFrom(ctx, r.db).Model(&member).
+		Clauses(clause.Returning{}).
+		Omit(clause.Associations, "id", "is_active", "created_at").
+		Where("id = ?", id).
+		Updates(patch)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update member", "error", result.Error)
+		if isForeignKeyViolation(result.Error) {
+			return nil, model.ErrMembershipCategoryNotFound
+		}
+		return nil, translateMemberError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrMemberNotFound
+	}
+	return withCategory(ctx, r.db, &member)
+}
+
+func (r *MemberRepositoryImpl) SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.Member, error) {
+	var member model.Member
+	result := dbFrom(ctx, r.db).Model(&member).
+		Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		Update("is_active", active)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update member", "error", result.Error)
+		return nil, translateMemberError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrMemberNotFound
+	}
+	return withCategory(ctx, r.db, &member)
+}
+
+// Delete locks the member so no checkout or hold can start while the member is
+// being deleted. Loans, fines and reservations of the member are kept.
+func (r *MemberRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var member model.Member
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&member).Error; err != nil {
+			return translateMemberError(err)
+		}
+
+		var busy int64
+		if err := tx.Model(&model.Member{}).Where("id = ? AND ("+memberInCirculation+")", id).Count(&busy).Error; err != nil {
+			return err
+		}
+		if busy > 0 {
+			return model.ErrMemberHasOpenLoans
+		}
+		return tx.Delete(&member).Error
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete member", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Member delete successful", "member_id", id)
+	return nil
+}
+
+// Restore fails with ErrMemberEmailConflict when another member registered
+// the same email after the delete.
+func (r *MemberRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	var member model.Member
+	result := dbFrom(ctx, r.db).Unscoped().Model(&member).
+		Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		UpdateColumn("deleted_at", nil)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to restore member", "error", result.Error)
+		return nil, translateMemberError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrMemberNotFound
+	}
+	return withCategory(ctx, r.db, &member)
+}
+
+// withCategory loads the membership category of a member read back from an
+// UPDATE ... RETURNING, which cannot preload it, so that every member returned
+// by the repository carries its category.
+func withCategory(ctx context.Context, db *gorm.DB, member *model.Member) (*model.Member, error) {
+	var category model.MembershipCategory
+	if err := dbFrom(ctx, db).Take(&category, "name = ?", member.Category).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to find membership category", "error", err)
+		return nil, translateMembershipCategoryError(err)
+	}
+	member.MembershipCategory = &category
+	return member, nil
+}
+
+// findMember loads a member together with its membership category.
+func findMember(db *gorm.DB, query string, arg any) (*model.Member, error) {
+	var member model.Member
+	err := db.Preload("MembershipCategory").Where(query, arg).Take(&member).Error
+	if err != nil {
+		slog.ErrorContext(db.Statement.Context, "Failed to find member", "error", err)
+		return nil, translateMemberError(err)
+	}
+	return &member, nil
+}
+
+func translateMemberError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return model.ErrMemberNotFound
+	case isUniqueViolation(err, ""):
+		return model.ErrMemberEmailConflict
+	default:
+		return translateError(err)
+	}
+}
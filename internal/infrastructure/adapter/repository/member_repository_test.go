@@ -0,0 +1,319 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const (
+	memberSelectByIDRegex  = `^SELECT \* FROM "members" WHERE id = \$1`
+	memberUpdateRegex      = `^UPDATE "members" SET .* WHERE id = \$\d+ AND "members"\."deleted_at" IS NULL RETURNING \*`
+	memberLockRegex        = `^SELECT \* FROM "members" WHERE id = \$1 AND "members"\."deleted_at" IS NULL LIMIT \$2 FOR UPDATE`
+	memberSoftDeleteRegex  = `^UPDATE "members" SET "deleted_at"=\$1 WHERE "members"\."id" = \$2 AND "members"\."deleted_at" IS NULL`
+	memberCirculationRegex = `^SELECT count\(\*\) FROM "members" WHERE \(id = \$1 AND \(EXISTS .*\)\) AND "members"\."deleted_at" IS NULL`
+	memberCategoryRegex    = `^SELECT \* FROM "membership_categories" WHERE name = \$1 LIMIT \$2`
+)
+
+var memberColumns = []string{"id", "full_name", "email", "phone", "category", "is_active", "created_at", "updated_at"}
+
+func memberRow(m *model.Member) *sqlmock.Rows {
+	return sqlmock.NewRows(memberColumns).
+		AddRow(m.ID, m.FullName, m.Email, m.Phone, m.Category, m.IsActive, m.CreatedAt, m.UpdatedAt)
+}
+
+func TestGivenExistingMemberWhenFindByIdThenReturnsMember(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	category := builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build()
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectQuery(memberSelectByIDRegex).
+		WithArgs(m.ID, 1).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectQuery(membershipCategorySelectRegex).
+		WithArgs(m.Category).
+		WillReturnRows(membershipCategoryRow(category))
+
+	got, err := repo.FindById(t.Context(), m.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, m.Email, got.Email)
+	assert.Equal(t, category.MaxLoans, got.MembershipCategory.MaxLoans)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownMemberWhenFindByIdThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectQuery(memberSelectByIDRegex).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows(memberColumns))
+
+	got, err := repo.FindById(t.Context(), id)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWhenFindByIdForUpdateThenLocksMemberRow(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "members" WHERE id = \$1 AND "members"\."deleted_at" IS NULL LIMIT \$2 FOR UPDATE OF "members"`).
+		WithArgs(m.ID, 1).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectQuery(membershipCategorySelectRegex).
+		WithArgs(m.Category).
+		WillReturnRows(sqlmock.NewRows(membershipCategoryColumns))
+
+	got, err := repo.FindByIdForUpdate(t.Context(), m.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, m.ID, got.ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownCategoryWhenSaveMemberThenReturnsCategoryNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "members"`).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_members_membership_category"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), builder.NewMemberBuilder().WithCategory("vip").Build())
+
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenNewMemberWhenSaveThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "members"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(m.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), m)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDuplicateEmailWhenSaveMemberThenReturnsEmailConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "members"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "members_email_key"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), builder.NewMemberBuilder().Build())
+
+	assert.ErrorIs(t, err, model.ErrMemberEmailConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenKnownEmailWhenFindByEmailThenReturnsMember(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "members" WHERE email = \$1 AND "members"\."deleted_at" IS NULL LIMIT \$2`).
+		WithArgs(m.Email, 1).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectQuery(membershipCategorySelectRegex).
+		WithArgs(m.Category).
+		WillReturnRows(sqlmock.NewRows(membershipCategoryColumns))
+
+	got, err := repo.FindByEmail(t.Context(), m.Email)
+
+	assert.NoError(t, err)
+	assert.Equal(t, m.ID, got.ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenPatchWhenUpdateMemberThenReturnsPersistedMemberWithCategory(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	category := builder.NewMembershipCategoryBuilder().WithName("student").WithMaxLoans(3).Build()
+	m := builder.NewMemberBuilder().WithName("Ada Lovelace").WithMembershipCategory(category).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(memberUpdateRegex).WillReturnRows(memberRow(m))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(memberCategoryRegex).
+		WithArgs("student", 1).
+		WillReturnRows(membershipCategoryRow(category))
+
+	got, err := repo.Update(t.Context(), m.ID, &model.Member{FullName: "Ada Lovelace", Category: "student"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", got.FullName)
+	assert.Equal(t, "student", got.MembershipCategory.Name)
+	assert.Equal(t, 3, got.MembershipCategory.MaxLoans)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWhenSetActiveThenReturnsMemberWithCategory(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	category := builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build()
+	m := builder.NewMemberBuilder().WithActive(false).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "members" SET "is_active"=\$1,"updated_at"=\$2 WHERE id = \$3 AND "members"\."deleted_at" IS NULL RETURNING \*`).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(memberCategoryRegex).
+		WithArgs(m.Category, 1).
+		WillReturnRows(membershipCategoryRow(category))
+
+	got, err := repo.SetActive(t.Context(), m.ID, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, m.Category, got.MembershipCategory.Name)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownMemberWhenSetActiveThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "members" SET "is_active"=\$1,"updated_at"=\$2 WHERE id = \$3 AND "members"\."deleted_at" IS NULL RETURNING \*`).
+		WillReturnRows(sqlmock.NewRows(memberColumns))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.SetActive(t.Context(), id, false)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithLoansWhenDeleteThenReturnsHasOpenLoans(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(memberLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectQuery(memberCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectRollback()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrMemberHasOpenLoans)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMemberWithoutOpenLoansWhenDeleteThenSoftDeletesMember(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(memberLockRegex).WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	sqlMock.ExpectQuery(memberCirculationRegex).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	sqlMock.ExpectExec(memberSoftDeleteRegex).WithArgs(sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Delete(t.Context(), id)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenEmailTakenWhenRestoreMemberThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "members" SET "deleted_at"=\$1 WHERE id = \$2 RETURNING \*`).WithArgs(nil, id).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	sqlMock.ExpectRollback()
+
+	_, err := repo.Restore(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrMemberEmailConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDeletedMemberWhenRestoreThenReturnsMemberWithCategory(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	category := builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build()
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "members" SET "deleted_at"=\$1 WHERE id = \$2 RETURNING \*`).WithArgs(nil, m.ID).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(memberCategoryRegex).
+		WithArgs(m.Category, 1).
+		WillReturnRows(membershipCategoryRow(category))
+
+	got, err := repo.Restore(t.Context(), m.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, m.Category, got.MembershipCategory.Name)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownMemberWhenRestoreThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "members" SET "deleted_at"=\$1 WHERE id = \$2 RETURNING \*`).WithArgs(nil, id).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectCommit()
+
+	_, err := repo.Restore(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrMemberNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenPageAndFiltersWhenFindAllThenReturnsOffsetPageWithCategories(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMemberRepository(gdb)
+	category := builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build()
+	m := builder.NewMemberBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "members" WHERE category = \$1 AND is_active = \$2`).
+		WithArgs(model.DefaultMemberCategory, true).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "members" WHERE category = \$1 AND is_active = \$2 `+
+		`AND "members"\."deleted_at" IS NULL ORDER BY "full_name","id" LIMIT \$3 OFFSET \$4$`).
+		WithArgs(model.DefaultMemberCategory, true, 6, 10).
+		WillReturnRows(memberRow(m))
+	sqlMock.ExpectQuery(membershipCategorySelectRegex).
+		WithArgs(model.DefaultMemberCategory).
+		WillReturnRows(membershipCategoryRow(category))
+
+	page, err := repo.FindAll(t.Context(), port.ListQuery{
+		Limit:   5,
+		Page:    3,
+		Filters: map[string]string{"category": model.DefaultMemberCategory, "is_active": "true"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), page.Total)
+	assert.Equal(t, model.DefaultMemberCategory, page.Items[0].MembershipCategory.Name)
+	assert.Nil(t, page.Next)
+	assert.NotNil(t, page.Prev)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
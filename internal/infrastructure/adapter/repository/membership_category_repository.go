@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// membershipTermColumns are replaced as a whole on update, zero included.
+var membershipTermColumns = []string{
+	"max_loans", "loan_period_days", "renewal_limit", "fine_daily_rate_cents", "fine_grace_days", "fine_max_cents",
+}
+
+type MembershipCategoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMembershipCategoryRepository(db *gorm.DB) port.MembershipCategoryPort {
+	return &MembershipCategoryRepositoryImpl{db: db}
+}
+
+func (r *MembershipCategoryRepositoryImpl) Save(ctx context.Context, category *model.MembershipCategory) error {
+	err := dbFrom(ctx, r.db).Create(category).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save membership category", "error", err)
+		return translateMembershipCategoryError(err)
+	}
+	slog.InfoContext(ctx, "Membership category save successful", "category", category.Name)
+	return nil
+}
+
+// Categories are keyed by name, so the name also breaks ties between rows
+// sorted by another column.
+var membershipCategoryList = listSpec[model.MembershipCategory]{
+	key:         "name",
+	keyCast:     "text",
+	keyOf:       func(c *model.MembershipCategory) string { return c.Name },
+	defaultSort: "name",
+	sorts: map[string]sortColumn[model.MembershipCategory]{
+		"name":       {cast: "text", value: func(c *model.MembershipCategory) string { return c.Name }},
+		"created_at": {cast: "timestamptz", value: func(c *model.MembershipCategory) string { return timeValue(c.CreatedAt) }},
+	},
+}
+
+func (r *MembershipCategoryRepositoryImpl) FindAll(
+	ctx context.Context,
+	query port.ListQuery,
+) (*port.Page[model.MembershipCategory], error) {
+	page, err := findPage(dbFrom(ctx, r.db), query, membershipCategoryList)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find membership categories", "error", err)
+		return nil, translateMembershipCategoryError(err)
+	}
+	return page, nil
+}
+
+func (r *MembershipCategoryRepositoryImpl) FindByName(ctx context.Context, name string) (*model.MembershipCategory, error) {
+	var category model.MembershipCategory
+	err := dbFrom(ctx, r.db).Take(&category, "name = ?", name).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find membership category", "error", err)
+		return nil, translateMembershipCategoryError(err)
+	}
+	return &category, nil
+}
+
+// Update replaces the terms of a category and returns the stored row.
+func (r *MembershipCategoryRepositoryImpl) Update(
+	ctx context.Context,
+	name string,
+	category *model.MembershipCategory,
+) (*model.MembershipCategory, error) {
+	var updated model.MembershipCategory
+	result := dbFrom(ctx, r.db).Model(&updated).
+		Clauses(clause.Returning{}).
+		Select(membershipTermColumns).
+		Where("name = ?", name).
+		Updates(category)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update membership category", "error", result.Error)
+		return nil, translateMembershipCategoryError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrMembershipCategoryNotFound
+	}
+	return &updated, nil
+}
+
+func translateMembershipCategoryError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return model.ErrMembershipCategoryNotFound
+	case isUniqueViolation(err, ""):
+		return model.ErrMembershipCategoryConflict
+	default:
+		return translateError(err)
+	}
+}
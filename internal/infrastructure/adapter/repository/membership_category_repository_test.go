@@ -0,0 +1,121 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const membershipCategorySelectRegex = `^SELECT \* FROM "membership_categories" WHERE "membership_categories"."name" = \$1`
+
+var membershipCategoryColumns = []string{
+	"name", "max_loans", "loan_period_days", "renewal_limit",
+	"fine_daily_rate_cents", "fine_grace_days", "fine_max_cents", "created_at", "updated_at",
+}
+
+func membershipCategoryRow(c *model.MembershipCategory) *sqlmock.Rows {
+	return sqlmock.NewRows(membershipCategoryColumns).
+		AddRow(c.Name, c.MaxLoans, c.LoanPeriodDays, c.RenewalLimit,
+			c.FineDailyRateCents, c.FineGraceDays, c.FineMaxCents, c.CreatedAt, c.UpdatedAt)
+}
+
+func TestGivenNewMembershipCategoryWhenSaveThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^INSERT INTO "membership_categories"`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), builder.NewMembershipCategoryBuilder().Build())
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDuplicateNameWhenSaveMembershipCategoryThenReturnsConflict(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^INSERT INTO "membership_categories"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "membership_categories_pkey"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), builder.NewMembershipCategoryBuilder().Build())
+
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryConflict)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCategoriesWhenFindAllThenReturnsOrderedByName(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	staff := builder.NewMembershipCategoryBuilder().WithName("staff").Build()
+	student := builder.NewMembershipCategoryBuilder().Build()
+	rows := membershipCategoryRow(staff).
+		AddRow(student.Name, student.MaxLoans, student.LoanPeriodDays, student.RenewalLimit,
+			student.FineDailyRateCents, student.FineGraceDays, student.FineMaxCents, student.CreatedAt, student.UpdatedAt)
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "membership_categories"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	sqlMock.ExpectQuery(`^SELECT \* FROM "membership_categories" ORDER BY "name" LIMIT \$1$`).WillReturnRows(rows)
+
+	got, err := repo.FindAll(t.Context(), port.ListQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, got.Items, 2)
+	assert.Equal(t, "staff", got.Items[0].Name)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownNameWhenFindByNameThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	sqlMock.ExpectQuery(`^SELECT \* FROM "membership_categories" WHERE name = \$1 LIMIT \$2`).
+		WithArgs("vip", 1).
+		WillReturnRows(sqlmock.NewRows(membershipCategoryColumns))
+
+	got, err := repo.FindByName(t.Context(), "vip")
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenNewTermsWhenUpdateMembershipCategoryThenReturnsPersistedCategory(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	c := builder.NewMembershipCategoryBuilder().WithMaxLoans(4).WithFine(0, 0, 0).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "membership_categories" SET .*"fine_daily_rate_cents"=\$\d+.* WHERE name = \$\d+ RETURNING \*`).
+		WillReturnRows(membershipCategoryRow(c))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Update(t.Context(), c.Name, c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, got.MaxLoans)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownNameWhenUpdateMembershipCategoryThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewMembershipCategoryRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^UPDATE "membership_categories" SET .* WHERE name = \$\d+ RETURNING \*`).
+		WillReturnRows(sqlmock.NewRows(membershipCategoryColumns))
+	sqlMock.ExpectCommit()
+
+	got, err := repo.Update(t.Context(), "vip", builder.NewMembershipCategoryBuilder().WithName("vip").Build())
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrMembershipCategoryNotFound)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
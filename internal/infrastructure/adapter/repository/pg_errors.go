@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"library/internal/domain/domainerror"
+)
+
+const (
+	uniqueViolationCode           = "23505"
+	foreignKeyViolationCode       = "23503"
+	notNullViolationCode          = "23502"
+	checkViolationCode            = "23514"
+	stringTooLongCode             = "22001"
+	invalidTextRepresentationCode = "22P02"
+)
+
+// isUniqueViolation reports whether err is a PostgreSQL unique violation. When
+// constraint is not empty the violated constraint or index must match it.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return false
+	}
+	return constraint == "" || pgErr.ConstraintName == constraint
+}
+
+// isForeignKeyViolation reports whether err is a PostgreSQL foreign key
+// violation, such as deleting a row that other rows still reference.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
+
+// translateError turns the GORM and PostgreSQL errors a repository does not
+// map to a specific domain error into a generic one of the right kind. Domain
+// errors and errors of any other origin, such as a lost connection, are
+// returned unchanged.
+func translateError(err error) error {
+	if _, ok := domainerror.As(err); ok || err == nil {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainerror.Wrap(domainerror.NotFound, "not_found", "resource not found", err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case uniqueViolationCode:
+		return domainerror.Wrap(domainerror.Conflict, "duplicate",
+			"a resource with the same unique value already exists", err)
+	case foreignKeyViolationCode:
+		return domainerror.Wrap(domainerror.Conflict, "reference_violation",
+			"the resource references a missing resource or is still referenced", err)
+	case notNullViolationCode, checkViolationCode, stringTooLongCode, invalidTextRepresentationCode:
+		return domainerror.Wrap(domainerror.Validation, "invalid_value",
+			"a value is missing, malformed or out of range", err)
+	default:
+		return err
+	}
+}
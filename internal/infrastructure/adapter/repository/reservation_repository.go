@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+const (
+	activeReservationPerMemberBookIndex = "uniq_active_reservation_per_member_book"
+
+	// activeReservation matches the partial index uniq_active_reservation_per_member_book.
+	activeReservation = "reservations.canceled_at IS NULL AND reservations.fulfilled_loan_id IS NULL AND " +
+		"reservations.expired_at IS NULL"
+	// reservationQueueOrder is the FIFO order of a book queue.
+	reservationQueueOrder = "reservations.reserved_at, reservations.id"
+)
+
+type ReservationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReservationRepository(db *gorm.DB) port.ReservationPort {
+	return &ReservationRepositoryImpl{db: db}
+}
+
+func (r *ReservationRepositoryImpl) Save(ctx context.Context, reservation *model.Reservation) error {
+	err := dbFrom(ctx, r.db).Omit(clause.Associations).Create(reservation).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save reservation", "error", err)
+		if isUniqueViolation(err, activeReservationPerMemberBookIndex) {
+			return model.ErrReservationAlreadyActive
+		}
+		return translateError(err)
+	}
+	slog.InfoContext(ctx, "Reservation save successful", "reservation_id", reservation.ID)
+	return nil
+}
+
+func (r *ReservationRepositoryImpl) FindById(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := dbFrom(ctx, r.db).Where("id = ?", id).Take(&reservation).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find reservation", "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrReservationNotFound
+		}
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// FindActiveByBook returns the queue of a book, head first, with positions set.
+func (r *ReservationRepositoryImpl) FindActiveByBook(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error) {
+	var reservations []model.Reservation
+	err := dbFrom(ctx, r.db).
+		Where("reservations.book_id = ? AND "+activeReservation, bookID).
+		Order(reservationQueueOrder).
+		Find(&reservations).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find book reservations", "error", err)
+		return nil, err
+	}
+	for i := range reservations {
+		reservations[i].Position = i + 1
+	}
+	return reservations, nil
+}
+
+// FindActiveByMember returns the holds a member is still waiting on, oldest first.
+func (r *ReservationRepositoryImpl) FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Reservation, error) {
+	var reservations []model.Reservation
+	err := dbFrom(ctx, r.db).
+		Where("reservations.member_id = ? AND "+activeReservation, memberID).
+		Order(reservationQueueOrder).
+		Find(&reservations).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find member reservations", "error", err)
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// Position counts the active reservations queued ahead of the given one.
+func (r *ReservationRepositoryImpl) Position(ctx context.Context, reservation *model.Reservation) (int, error) {
+	var ahead int64
+	err := dbFrom(ctx, r.db).Model(&model.Reservation{}).
+		Where("reservations.book_id = ? AND "+activeReservation, reservation.BookID).
+		Where("(reservations.reserved_at, reservations.id) < (?, ?)", reservation.ReservedAt, reservation.ID).
+		Count(&ahead).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to compute reservation position", "error", err)
+		return 0, err
+	}
+	return int(ahead) + 1, nil
+}
+
+func (r *ReservationRepositoryImpl) ExistsActiveForBook(
+	ctx context.Context,
+	bookID, excludeMemberID uuid.UUID,
+) (bool, error) {
+	var count int64
+	err := dbFrom(ctx, r.db).Model(&model.Reservation{}).
+		Where("reservations.book_id = ? AND reservations.member_id <> ? AND "+activeReservation, bookID, excludeMemberID).
+		Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to check active reservations", "error", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindNextForBook locks the first reservation still waiting for a copy,
+// skipping members that are no longer active. Rows already locked by a
+// concurrent return are skipped so two returned copies never go to the same
+// member.
+func (r *ReservationRepositoryImpl) FindNextForBook(ctx context.Context, bookID uuid.UUID) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := dbFrom(ctx, r.db).
+		Joins("JOIN members ON members.id = reservations.member_id AND members.is_active").
+		Where("reservations.book_id = ? AND reservations.held_copy_id IS NULL AND "+activeReservation, bookID).
+		Order(reservationQueueOrder).
+		Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Table:    clause.Table{Name: "reservations"},
+			Options:  "SKIP LOCKED",
+		}).
+		Take(&reservation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrReservationNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to find next reservation", "error", err)
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// FindReadyByCopy locks the active reservation a copy is held for.
+func (r *ReservationRepositoryImpl) FindReadyByCopy(ctx context.Context, copyID uuid.UUID) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := dbFrom(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("reservations.held_copy_id = ? AND "+activeReservation, copyID).
+		Take(&reservation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrReservationNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to find held reservation", "error", err)
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// FindHoldShelf returns every copy waiting for pickup, closest deadline first.
+func (r *ReservationRepositoryImpl) FindHoldShelf(ctx context.Context) ([]model.Reservation, error) {
+	var reservations []model.Reservation
+	err := dbFrom(ctx, r.db).
+		Where("reservations.held_copy_id IS NOT NULL AND " + activeReservation).
+		Order("reservations.pickup_deadline, reservations.id").
+		Find(&reservations).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find hold shelf", "error", err)
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// FindExpiredHolds locks up to limit holds whose pickup deadline is before
+// asOf, skipping rows another sweeper is already handling.
+func (r *ReservationRepositoryImpl) FindExpiredHolds(
+	ctx context.Context,
+	asOf time.Time,
+	limit int,
+) ([]model.Reservation, error) {
+	var reservations []model.Reservation
+	err := dbFrom(ctx, r.db).
+		Where("reservations.pickup_deadline < ? AND "+activeReservation, asOf).
+		Order("reservations.pickup_deadline, reservations.id").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Find(&reservations).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find expired holds", "error", err)
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// MarkReady puts a waiting reservation on the hold shelf.
+func (r *ReservationRepositoryImpl) MarkReady(ctx context.Context, reservation *model.Reservation) error {
+	result := dbFrom(ctx, r.db).Model(&model.Reservation{}).
+		Where("id = ? AND reservations.held_copy_id IS NULL AND "+activeReservation, reservation.ID).
+		Updates(map[string]any{
+			"held_copy_id":    reservation.HeldCopyID,
+			"ready_at":        reservation.ReadyAt,
+			"pickup_deadline": reservation.PickupDeadline,
+		})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to mark reservation ready", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrReservationNotActive
+	}
+	return nil
+}
+
+func (r *ReservationRepositoryImpl) Cancel(ctx context.Context, reservation *model.Reservation) error {
+	return r.close(ctx, reservation, "canceled_at", reservation.CanceledAt)
+}
+
+func (r *ReservationRepositoryImpl) Fulfil(ctx context.Context, reservation *model.Reservation) error {
+	return r.close(ctx, reservation, "fulfilled_loan_id", reservation.FulfilledLoanID)
+}
+
+func (r *ReservationRepositoryImpl) Expire(ctx context.Context, reservation *model.Reservation) error {
+	return r.close(ctx, reservation, "expired_at", reservation.ExpiredAt)
+}
+
+// close takes an active reservation out of the queue.
+func (r *ReservationRepositoryImpl) close(ctx context.Context, reservation *model.Reservation, column string, value any) error {
+	result := dbFrom(ctx, r.db).Model(&model.Reservation{}).
+		Where("id = ? AND "+activeReservation, reservation.ID).
+		Update(column, value)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to update reservation", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrReservationNotActive
+	}
+	return nil
+}
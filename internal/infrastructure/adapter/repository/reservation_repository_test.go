@@ -0,0 +1,308 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const reservationExistsRegex = `^SELECT count\(\*\) FROM "reservations" WHERE reservations\.book_id = \$1 AND reservations\.member_id <> \$2 AND ` +
+	`reservations\.canceled_at IS NULL AND reservations\.fulfilled_loan_id IS NULL AND reservations\.expired_at IS NULL`
+
+func TestGivenHoldByAnotherMemberWhenExistsActiveForBookThenReturnsTrue(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	bookID, memberID := uuid.New(), uuid.New()
+	sqlMock.ExpectQuery(reservationExistsRegex).
+		WithArgs(bookID, memberID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	exists, err := repo.ExistsActiveForBook(t.Context(), bookID, memberID)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenNoHoldsWhenExistsActiveForBookThenReturnsFalse(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	sqlMock.ExpectQuery(reservationExistsRegex).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	exists, err := repo.ExistsActiveForBook(t.Context(), uuid.New(), uuid.New())
+
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGivenDatabaseErrorWhenExistsActiveForBookThenReturnsError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	sqlMock.ExpectQuery(reservationExistsRegex).WillReturnError(errors.New("db down"))
+
+	exists, err := repo.ExistsActiveForBook(t.Context(), uuid.New(), uuid.New())
+
+	assert.Error(t, err)
+	assert.False(t, exists)
+}
+
+var reservationColumns = []string{
+	"id", "member_id", "book_id", "reserved_at", "canceled_at", "fulfilled_loan_id",
+	"held_copy_id", "ready_at", "pickup_deadline", "expired_at", "created_at",
+}
+
+func reservationRow(r *model.Reservation) *sqlmock.Rows {
+	return sqlmock.NewRows(reservationColumns).
+		AddRow(r.ID, r.MemberID, r.BookID, r.ReservedAt, r.CanceledAt, r.FulfilledLoanID,
+			r.HeldCopyID, r.ReadyAt, r.PickupDeadline, r.ExpiredAt, r.CreatedAt)
+}
+
+func TestGivenNewHoldWhenSaveReservationThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "reservations"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(reservation.ID))
+	sqlMock.ExpectCommit()
+
+	err := repo.Save(t.Context(), reservation)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenActiveHoldIndexViolationWhenSaveReservationThenReturnsAlreadyActive(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`^INSERT INTO "reservations"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "uniq_active_reservation_per_member_book"})
+	sqlMock.ExpectRollback()
+
+	err := repo.Save(t.Context(), builder.NewReservationBuilder().Build())
+
+	assert.ErrorIs(t, err, model.ErrReservationAlreadyActive)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownReservationWhenFindByIdThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	id := uuid.New()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations" WHERE id = \$1 LIMIT \$2`).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows(reservationColumns))
+
+	got, err := repo.FindById(t.Context(), id)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrReservationNotFound)
+}
+
+func TestGivenBookQueueWhenFindActiveByBookThenReturnsPositionsInOrder(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	first := builder.NewReservationBuilder().Build()
+	second := builder.NewReservationBuilder().WithBookID(first.BookID).Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations" WHERE reservations\.book_id = \$1 AND .* ORDER BY reservations\.reserved_at, reservations\.id`).
+		WithArgs(first.BookID).
+		WillReturnRows(reservationRow(first).
+			AddRow(second.ID, second.MemberID, second.BookID, second.ReservedAt, nil, nil, nil, nil, nil, nil, second.CreatedAt))
+
+	got, err := repo.FindActiveByBook(t.Context(), first.BookID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 1, got[0].Position)
+	assert.Equal(t, 2, got[1].Position)
+}
+
+func TestGivenMemberHoldsWhenFindActiveByMemberThenReturnsOldestFirst(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	hold := builder.NewReservationBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations" WHERE reservations\.member_id = \$1 AND .* ` +
+		`ORDER BY reservations\.reserved_at, reservations\.id`).
+		WithArgs(hold.MemberID).
+		WillReturnRows(reservationRow(hold))
+
+	got, err := repo.FindActiveByMember(t.Context(), hold.MemberID)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenTwoHoldsAheadWhenPositionThenReturnsThree(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT count\(\*\) FROM "reservations" WHERE .* AND \(reservations\.reserved_at, reservations\.id\) < \(\$2, \$3\)`).
+		WithArgs(reservation.BookID, reservation.ReservedAt, reservation.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	position, err := repo.Position(t.Context(), reservation)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, position)
+}
+
+func TestGivenQueuedHoldWhenFindNextForBookThenLocksHeadSkippingLockedRows(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	head := builder.NewReservationBuilder().Build()
+	sqlMock.ExpectQuery(`^SELECT "reservations"\..* FROM "reservations" JOIN members ON members\.id = reservations\.member_id AND members\.is_active `+
+		`WHERE .* ORDER BY reservations\.reserved_at, reservations\.id LIMIT \$2 FOR UPDATE OF "reservations" SKIP LOCKED`).
+		WithArgs(head.BookID, 1).
+		WillReturnRows(reservationRow(head))
+
+	got, err := repo.FindNextForBook(t.Context(), head.BookID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, head.ID, got.ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenEmptyQueueWhenFindNextForBookThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	sqlMock.ExpectQuery(`^SELECT .* FROM "reservations"`).WillReturnRows(sqlmock.NewRows(reservationColumns))
+
+	got, err := repo.FindNextForBook(t.Context(), uuid.New())
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrReservationNotFound)
+}
+
+func TestGivenActiveHoldWhenCancelThenSetsCanceledAt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().WithCanceledAt(time.Now()).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "reservations" SET "canceled_at"=\$1 WHERE id = \$2 AND reservations\.canceled_at IS NULL`).
+		WithArgs(*reservation.CanceledAt, reservation.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Cancel(t.Context(), reservation)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenClosedHoldWhenFulfilThenReturnsNotActive(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().WithFulfilledLoanID(uuid.New()).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "reservations" SET "fulfilled_loan_id"=\$1`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	err := repo.Fulfil(t.Context(), reservation)
+
+	assert.ErrorIs(t, err, model.ErrReservationNotActive)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyOnHoldShelfWhenFindReadyByCopyThenLocksHold(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	copyID := uuid.New()
+	hold := builder.NewReservationBuilder().WithHeldCopy(copyID, time.Now().AddDate(0, 0, 3)).Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations" WHERE reservations\.held_copy_id = \$1 AND .* LIMIT \$2 FOR UPDATE$`).
+		WithArgs(copyID, 1).
+		WillReturnRows(reservationRow(hold))
+
+	got, err := repo.FindReadyByCopy(t.Context(), copyID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &copyID, got.HeldCopyID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenCopyNotOnHoldShelfWhenFindReadyByCopyThenReturnsNotFound(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations"`).WillReturnRows(sqlmock.NewRows(reservationColumns))
+
+	got, err := repo.FindReadyByCopy(t.Context(), uuid.New())
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrReservationNotFound)
+}
+
+func TestGivenStaleHoldsWhenFindExpiredHoldsThenLocksBatchSkippingLockedRows(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	asOf := time.Now()
+	stale := builder.NewReservationBuilder().WithHeldCopy(uuid.New(), asOf.AddDate(0, 0, -1)).Build()
+	sqlMock.ExpectQuery(`^SELECT \* FROM "reservations" WHERE reservations\.pickup_deadline < \$1 AND .* `+
+		`ORDER BY reservations\.pickup_deadline, reservations\.id LIMIT \$2 FOR UPDATE SKIP LOCKED$`).
+		WithArgs(asOf, 50).
+		WillReturnRows(reservationRow(stale))
+
+	got, err := repo.FindExpiredHolds(t.Context(), asOf, 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenWaitingHoldWhenMarkReadyThenSetsHeldCopyAndDeadline(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().WithHeldCopy(uuid.New(), time.Now().AddDate(0, 0, 3)).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "reservations" SET "held_copy_id"=\$1,"pickup_deadline"=\$2,"ready_at"=\$3 `+
+		`WHERE id = \$4 AND reservations\.held_copy_id IS NULL`).
+		WithArgs(reservation.HeldCopyID, reservation.PickupDeadline, reservation.ReadyAt, reservation.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.MarkReady(t.Context(), reservation)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenHoldAlreadyShelvedWhenMarkReadyThenReturnsNotActive(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().WithHeldCopy(uuid.New(), time.Now()).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "reservations" SET`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	err := repo.MarkReady(t.Context(), reservation)
+
+	assert.ErrorIs(t, err, model.ErrReservationNotActive)
+}
+
+func TestGivenStaleHoldWhenExpireThenSetsExpiredAt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewReservationRepository(gdb)
+	reservation := builder.NewReservationBuilder().WithExpiredAt(time.Now()).Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`^UPDATE "reservations" SET "expired_at"=\$1 WHERE id = \$2 AND reservations\.canceled_at IS NULL`).
+		WithArgs(*reservation.ExpiredAt, reservation.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := repo.Expire(t.Context(), reservation)
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+// searchFacetLimit is the number of values returned for each facet.
+const searchFacetLimit = 10
+
+// searchMatches selects the live books matching the search terms or ISBN
+// with their rank and author names. Title, description and author names are
+// each tested against the expression indexed by migrations 001, 009 and 011, so
+// every branch of the match can be served by a GIN index.
+// Titles weigh more than author names, author names more than descriptions,
+// and an exact ISBN puts the book first. ISBNs are compared without hyphens
+// or spaces and upper-cased, as isbnOf reads them from the query.
+const searchMatches = `WITH search AS (SELECT to_tsquery('simple', ?) AS q, ?::text AS isbn),
+matches AS (
+	SELECT b.id, n.names, ts_rank(
+		setweight(to_tsvector('simple', b.title), 'A') ||
+		setweight(to_tsvector('simple', coalesce(n.names, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce(b.description, '')), 'C'),
+		s.q) + CASE WHEN upper(translate(b.isbn, '- ', '')) = s.isbn THEN 1 ELSE 0 END AS rank
+	FROM search s, books b
+	LEFT JOIN LATERAL (
+		SELECT string_agg(a.first_name || ' ' || a.last_name, ', ' ORDER BY ba.position) AS names
+		FROM book_authors ba JOIN authors a ON a.id = ba.author_id AND a.deleted_at IS NULL
+		WHERE ba.book_id = b.id
+	) n ON true
+	WHERE b.deleted_at IS NULL
+	AND (to_tsvector('simple', b.title) @@ s.q
+		OR to_tsvector('simple', coalesce(b.description, '')) @@ s.q
+		OR upper(translate(b.isbn, '- ', '')) = s.isbn
+		OR b.id IN (
+			SELECT ba.book_id FROM book_authors ba JOIN authors a ON a.id = ba.author_id AND a.deleted_at IS NULL
+			WHERE to_tsvector('simple', a.first_name || ' ' || a.last_name) @@ s.q))`
+
+// searchHits reads a page of matches with their highlights. The highlights
+// are HTML: the text is escaped before ts_headline wraps the matches in
+// <mark>, so markup stored in a title, description or author name is shown as
+// text. The parser reads the escapes as entities, which are never highlighted.
+var searchHits = `
+SELECT b.*, m.rank,
+	ts_headline('simple', ` + escapeHTML("b.title") + `, s.q,
+		'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS title_highlight,
+	ts_headline('simple', ` + escapeHTML("coalesce(b.description, '')") + `, s.q,
+		'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS description_highlight,
+	ts_headline('simple', ` + escapeHTML("coalesce(m.names, '')") + `, s.q,
+		'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS authors_highlight
+FROM search s, matches m JOIN books b ON b.id = m.id
+ORDER BY m.rank DESC, b.id
+LIMIT ? OFFSET ?`
+
+const searchYearFacets = `
+SELECT b.published_year AS year, count(*) AS count
+FROM matches m JOIN books b ON b.id = m.id
+WHERE b.published_year > 0
+GROUP BY b.published_year
+ORDER BY count DESC, year DESC
+LIMIT ?`
+
+const searchAuthorFacets = `
+SELECT a.id AS author_id, a.first_name, a.last_name, count(*) AS count
+FROM matches m
+JOIN book_authors ba ON ba.book_id = m.id
+JOIN authors a ON a.id = ba.author_id AND a.deleted_at IS NULL
+GROUP BY a.id
+ORDER BY count DESC, a.last_name, a.first_name
+LIMIT ?`
+
+// isbnPattern matches an ISBN-10 or ISBN-13 once hyphens and spaces are gone.
+var isbnPattern = regexp.MustCompile(`^(\d{9}[\dX]|\d{13})$`)
+
+type searchRow struct {
+	model.Book
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+	AuthorsHighlight     string
+}
+
+type SearchRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) port.SearchPort {
+	return &SearchRepositoryImpl{db: db}
+}
+
+// SearchBooks counts the matches first and only reads the page and the facets
+// when there is at least one.
+func (r *SearchRepositoryImpl) SearchBooks(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error) {
+	terms := toTSQuery(query.Text)
+	if terms == "" {
+		return nil, model.ErrInvalidSearchQuery
+	}
+	args := []any{terms, isbnOf(query.Text)}
+	matches := searchMatches
+	if query.Year != nil {
+		matches += "\n\tAND b.published_year = ?"
+		args = append(args, *query.Year)
+	}
+	if query.AuthorID != nil {
+		matches += "\n\tAND b.id IN (SELECT book_id FROM book_authors WHERE author_id = ?)"
+		args = append(args, *query.AuthorID)
+	}
+	matches += "\n)"
+	with := func(extra ...any) []any { return append(append([]any{}, args...), extra...) }
+
+	db := dbFrom(ctx, r.db)
+	result := &model.SearchResult{
+		Hits:   []model.SearchHit{},
+		Facets: model.SearchFacets{PublishedYears: []model.YearFacet{}, Authors: []model.AuthorFacet{}},
+	}
+	if err := db.Raw(matches+"\nSELECT count(*) FROM matches", args...).Scan(&result.Total).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to count search matches", "error", err)
+		return nil, translateError(err)
+	}
+	if result.Total == 0 {
+		return result, nil
+	}
+
+	var rows []searchRow
+	if err := db.Raw(matches+searchHits, with(query.Limit, (query.Page-1)*query.Limit)...).Scan(&rows).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to search books", "error", err)
+		return nil, translateError(err)
+	}
+	for _, row := range rows {
+		result.Hits = append(result.Hits, model.SearchHit{
+			Book: row.Book,
+			Rank: row.Rank,
+			Highlights: model.SearchHighlights{
+				Title:       row.TitleHighlight,
+				Description: row.DescriptionHighlight,
+				Authors:     row.AuthorsHighlight,
+			},
+		})
+	}
+
+	if err := db.Raw(matches+searchYearFacets, with(searchFacetLimit)...).Scan(&result.Facets.PublishedYears).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to count search matches by year", "error", err)
+		return nil, translateError(err)
+	}
+	if err := db.Raw(matches+searchAuthorFacets, with(searchFacetLimit)...).Scan(&result.Facets.Authors).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to count search matches by author", "error", err)
+		return nil, translateError(err)
+	}
+	return result, nil
+}
+
+// toTSQuery turns the text of a search into a tsquery. Words must all match,
+// the words of a "quoted phrase" must follow each other, and a word ending in
+// * matches any word it starts. Anything other than a letter or a digit splits
+// words, so the text can never add tsquery operators of its own. It returns ""
+// when the text has no words.
+func toTSQuery(text string) string {
+	var groups []string
+	for i, part := range strings.Split(text, `"`) {
+		var terms []string
+		for _, word := range strings.Fields(part) {
+			tokens := strings.FieldsFunc(word, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			})
+			for j, token := range tokens {
+				term := "'" + strings.ToLower(token) + "'"
+				if j == len(tokens)-1 && strings.HasSuffix(word, "*") {
+					term += ":*"
+				}
+				terms = append(terms, term)
+			}
+		}
+		switch {
+		case len(terms) == 0:
+		case i%2 == 1 && len(terms) > 1:
+			groups = append(groups, "("+strings.Join(terms, " <-> ")+")")
+		default:
+			groups = append(groups, terms...)
+		}
+	}
+	return strings.Join(groups, " & ")
+}
+
+// escapeHTML returns the SQL expression that escapes the HTML special
+// characters of the text expression, & first.
+func escapeHTML(expr string) string {
+	return "replace(replace(replace(replace(" + expr +
+		", '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '\"', '&quot;')"
+}
+
+// isbnOf returns the text as a bare ISBN when it reads as one, or nil so that
+// no ISBN matches.
+func isbnOf(text string) any {
+	isbn := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(text))
+	if !isbnPattern.MatchString(isbn) {
+		return nil
+	}
+	return isbn
+}
@@ -0,0 +1,136 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const searchCountRegex = `^WITH search AS \(SELECT to_tsquery\('simple', \$1\) AS q, \$2::text AS isbn\).*SELECT count\(\*\) FROM matches$`
+
+func TestGivenWordsPhraseAndPrefixWhenSearchBooksThenReturnsRankedHitsAndFacets(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+	book := builder.NewBookBuilder().Build()
+	author := builder.NewAuthorBuilder().Build()
+	terms := `'dark' & ('left' <-> 'hand') & 'ursu':*`
+	sqlMock.ExpectQuery(searchCountRegex).
+		WithArgs(terms, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectQuery(`SELECT b\.\*, m\.rank,.*ORDER BY m\.rank DESC, b\.id\s+LIMIT \$3 OFFSET \$4$`).
+		WithArgs(terms, nil, 20, 20).
+		WillReturnRows(sqlmock.NewRows(append(bookColumns, "rank", "title_highlight", "description_highlight", "authors_highlight")).
+			AddRow(book.ID, book.Title, book.ISBN, book.Description, book.PublishedYear, book.CreatedAt, book.UpdatedAt,
+				0.6, "The <mark>Left</mark> <mark>Hand</mark>", "", "<mark>Ursula</mark> Le Guin"))
+	sqlMock.ExpectQuery(`GROUP BY b\.published_year.*LIMIT \$3$`).
+		WithArgs(terms, nil, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "count"}).AddRow(1969, 1))
+	sqlMock.ExpectQuery(`GROUP BY a\.id.*LIMIT \$3$`).
+		WithArgs(terms, nil, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "first_name", "last_name", "count"}).
+			AddRow(author.ID, author.FirstName, author.LastName, 1))
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{Text: `dark "Left Hand" ursu*`, Limit: 20, Page: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), got.Total)
+	assert.Len(t, got.Hits, 1)
+	assert.Equal(t, book.ID, got.Hits[0].Book.ID)
+	assert.Equal(t, 0.6, got.Hits[0].Rank)
+	assert.Equal(t, "<mark>Ursula</mark> Le Guin", got.Hits[0].Highlights.Authors)
+	assert.Equal(t, []model.YearFacet{{Year: 1969, Count: 1}}, got.Facets.PublishedYears)
+	assert.Equal(t, author.ID, got.Facets.Authors[0].AuthorID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenHTMLInDescriptionWhenSearchBooksThenEscapeItBeforeHighlighting(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+	book := builder.NewBookBuilder().WithDescription(`Dune <img src=x onerror=alert(1)>`).Build()
+	highlight := `<mark>Dune</mark> &lt;img src=x onerror=alert(1)&gt;`
+	sqlMock.ExpectQuery(searchCountRegex).
+		WithArgs("'dune'", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectQuery(`ts_headline\('simple', replace\(replace\(replace\(replace\(coalesce\(b\.description, ''\), `+
+		`'&', '&amp;'\), '<', '&lt;'\), '>', '&gt;'\), '"', '&quot;'\), s\.q,`).
+		WithArgs("'dune'", nil, 20, 0).
+		WillReturnRows(sqlmock.NewRows(append(bookColumns, "rank", "title_highlight", "description_highlight", "authors_highlight")).
+			AddRow(book.ID, book.Title, book.ISBN, book.Description, book.PublishedYear, book.CreatedAt, book.UpdatedAt,
+				0.3, book.Title, highlight, ""))
+	sqlMock.ExpectQuery(`GROUP BY b\.published_year`).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "count"}))
+	sqlMock.ExpectQuery(`GROUP BY a\.id`).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "first_name", "last_name", "count"}))
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{Text: "dune", Limit: 20, Page: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, highlight, got.Hits[0].Highlights.Description)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenISBNAndFacetFiltersWhenSearchBooksThenMatchesISBNWithinFacet(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+	year := 1980
+	authorID := uuid.New()
+	sqlMock.ExpectQuery(`AND b\.published_year = \$3\s+AND b\.id IN \(SELECT book_id FROM book_authors WHERE author_id = \$4\)\s+\)\s+SELECT count`).
+		WithArgs(`'978' & '0' & '306' & '40615' & '7'`, "9780306406157", year, authorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{
+		Text: "978-0-306-40615-7", Year: &year, AuthorID: &authorID, Limit: 20, Page: 1,
+	})
+
+	assert.NoError(t, err)
+	assert.Zero(t, got.Total)
+	assert.Empty(t, got.Hits)
+	assert.Empty(t, got.Facets.Authors)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenISBNWithSpacesAndLowerCaseXWhenSearchBooksThenCompareNormalisedISBNs(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+	sqlMock.ExpectQuery(`OR upper\(translate\(b\.isbn, '- ', ''\)\) = s\.isbn`).
+		WithArgs(`'0' & '306' & '40615' & 'x'`, "030640615X").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{Text: "0 306 40615 x", Limit: 20, Page: 1})
+
+	assert.NoError(t, err)
+	assert.Zero(t, got.Total)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOnlyOperatorsWhenSearchBooksThenReturnsInvalidQuery(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{Text: `"" & | !*`, Limit: 20, Page: 1})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, model.ErrInvalidSearchQuery)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenDatabaseErrorWhenSearchBooksThenReturnsError(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	repo := repository.NewSearchRepository(gdb)
+	sqlMock.ExpectQuery(searchCountRegex).WillReturnError(errors.New("connection reset"))
+
+	got, err := repo.SearchBooks(t.Context(), port.SearchQuery{Text: "dune", Limit: 20, Page: 1})
+
+	assert.Nil(t, got)
+	assert.Error(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"library/internal/domain/port"
+)
+
+type txKey struct{}
+
+type TransactionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTransactionRepository(db *gorm.DB) port.TransactionPort {
+	return &TransactionRepositoryImpl{db: db}
+}
+
+// WithinTransaction opens a transaction and stores it in the context passed to
+// fn. A call made while a transaction is already open joins it.
+func (r *TransactionRepositoryImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// dbFrom returns the transaction bound to ctx, or db scoped to ctx when no
+// transaction is open.
+func dbFrom(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// readFrom is dbFrom for lookups: soft-deleted rows are included when ctx was
+// marked with port.WithDeleted.
+func readFrom(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if port.IncludesDeleted(ctx) {
+		return dbFrom(ctx, db).Unscoped()
+	}
+	return dbFrom(ctx, db)
+}
@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/infrastructure/adapter/repository"
+	"library/internal/test/builder"
+	"library/internal/test/mock"
+)
+
+const bookCopySelectForUpdateRegex = `^SELECT book_copies\.\*, .* FROM "book_copies" WHERE book_copies\.id = \$1 AND "book_copies"\."deleted_at" IS NULL LIMIT \$2 FOR UPDATE`
+
+func TestGivenSuccessfulCallbackWhenWithinTransactionThenCommits(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	txRepo := repository.NewTransactionRepository(gdb)
+	copies := repository.NewBookCopyRepository(gdb)
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(bookCopySelectForUpdateRegex).
+		WithArgs(bookCopy.ID, 1).
+		WillReturnRows(bookCopyRow(bookCopy))
+	sqlMock.ExpectCommit()
+
+	err := txRepo.WithinTransaction(t.Context(), func(ctx context.Context) error {
+		_, err := copies.FindByIdForUpdate(ctx, bookCopy.ID)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenFailingCallbackWhenWithinTransactionThenRollsBack(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	txRepo := repository.NewTransactionRepository(gdb)
+	expectedErr := errors.New("business rule failed")
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectRollback()
+
+	err := txRepo.WithinTransaction(t.Context(), func(ctx context.Context) error {
+		return expectedErr
+	})
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenOpenTransactionWhenWithinTransactionAgainThenJoinsIt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	txRepo := repository.NewTransactionRepository(gdb)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectCommit()
+
+	err := txRepo.WithinTransaction(t.Context(), func(ctx context.Context) error {
+		return txRepo.WithinTransaction(ctx, func(ctx context.Context) error { return nil })
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -4,31 +4,86 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"library/internal/infrastructure/controller"
+	"library/internal/infrastructure/metrics"
 )
 
 type Handlers struct {
-	Author *controller.AuthorController
+	Author      *controller.AuthorController
+	Book        *controller.BookController
+	BookAuthor  *controller.BookAuthorController
+	BookCopy    *controller.BookCopyController
+	Loan        *controller.LoanController
+	Fine        *controller.FineController
+	Reservation *controller.ReservationController
+	Member      *controller.MemberController
+	Category    *controller.MembershipCategoryController
+	Search      *controller.SearchController
+	Health      *controller.HealthController
 }
 
-func NewHandlers(author *controller.AuthorController) Handlers {
+func NewHandlers(
+	author *controller.AuthorController,
+	book *controller.BookController,
+	bookAuthor *controller.BookAuthorController,
+	bookCopy *controller.BookCopyController,
+	loan *controller.LoanController,
+	fine *controller.FineController,
+	reservation *controller.ReservationController,
+	member *controller.MemberController,
+	category *controller.MembershipCategoryController,
+	search *controller.SearchController,
+	health *controller.HealthController,
+) Handlers {
 	return Handlers{
-		Author: author,
+		Author:      author,
+		Book:        book,
+		BookAuthor:  bookAuthor,
+		BookCopy:    bookCopy,
+		Loan:        loan,
+		Fine:        fine,
+		Reservation: reservation,
+		Member:      member,
+		Category:    category,
+		Search:      search,
+		Health:      health,
 	}
 }
 
+// RegisterMetrics serves m at /metrics and counts and times the requests of
+// every route registered after it, so it must be called before RegisterRoutes.
+func RegisterMetrics(r *gin.Engine, m *metrics.Metrics) {
+	r.Use(m.Middleware())
+	r.GET("/metrics", gin.WrapH(m.Handler()))
+}
+
 func RegisterRoutes(r *gin.Engine, h Handlers) {
-	base := r.Group("/api/v1/library")
+	base := r.Group("/api/v1/library", controller.ErrorHandler())
 
-	healthRoutes(r)
+	healthRoutes(r, h.Health)
 	registerAuthorRoutes(base, h.Author)
+	registerBookRoutes(base, h.Book)
+	registerBookAuthorRoutes(base, h.BookAuthor)
+	registerBookCopyRoutes(base, h.BookCopy)
+	registerLoanRoutes(base, h.Loan)
+	registerFineRoutes(base, h.Fine)
+	registerReservationRoutes(base, h.Reservation)
+	registerMemberRoutes(base, h.Member)
+	registerMembershipCategoryRoutes(base, h.Category)
+	registerSearchRoutes(base, h.Search)
 }
 
-func healthRoutes(r *gin.Engine) {
-	r.GET("/ping", func(c *gin.Context) {
-		c.JSON(200, gin.H{
+func healthRoutes(r *gin.Engine, c *controller.HealthController) {
+	r.GET("/ping", func(ctx *gin.Context) {
+		ctx.JSON(200, gin.H{
 			"status": "pong",
 		})
 	})
+	if c == nil {
+		return
+	}
+	probes := r.Group("/health")
+	probes.GET("/live", c.Live)
+	probes.GET("/ready", c.Ready)
 }
 
 func registerAuthorRoutes(group *gin.RouterGroup, c *controller.AuthorController) {
@@ -40,4 +95,117 @@ func registerAuthorRoutes(group *gin.RouterGroup, c *controller.AuthorController
 	authors.GET("", c.GetAll)
 	authors.GET("/:id", c.GetById)
 	authors.PUT("/:id", c.Update)
+	authors.PATCH("/:id", c.Patch)
+	authors.DELETE("/:id", c.Delete)
+	authors.POST("/:id/restore", c.Restore)
+}
+
+func registerBookRoutes(group *gin.RouterGroup, c *controller.BookController) {
+	if c == nil {
+		return
+	}
+	books := group.Group("/books")
+	books.POST("", c.Create)
+	books.GET("", c.GetAll)
+	books.GET("/:id", c.GetById)
+	books.PUT("/:id", c.Update)
+	books.DELETE("/:id", c.Delete)
+	books.POST("/:id/restore", c.Restore)
+}
+
+func registerBookAuthorRoutes(group *gin.RouterGroup, c *controller.BookAuthorController) {
+	if c == nil {
+		return
+	}
+	books := group.Group("/books/:id/authors")
+	books.POST("", c.Link)
+	books.GET("", c.GetBookAuthors)
+	books.DELETE("/:authorId", c.Unlink)
+
+	group.GET("/authors/:id/books", c.GetAuthorBooks)
+}
+
+func registerBookCopyRoutes(group *gin.RouterGroup, c *controller.BookCopyController) {
+	if c == nil {
+		return
+	}
+	books := group.Group("/books/:id/copies")
+	books.POST("", c.Register)
+	books.GET("", c.GetBookCopies)
+
+	copies := group.Group("/copies")
+	copies.GET("/:id", c.GetById)
+	copies.GET("/barcode/:barcode", c.GetByBarcode)
+	copies.POST("/:id/retire", c.Retire)
+	copies.POST("/:id/reactivate", c.Reactivate)
+	copies.DELETE("/:id", c.Delete)
+	copies.POST("/:id/restore", c.Restore)
+}
+
+func registerLoanRoutes(group *gin.RouterGroup, c *controller.LoanController) {
+	if c == nil {
+		return
+	}
+	loans := group.Group("/loans")
+	loans.POST("/checkout", c.Checkout)
+	loans.POST("/return", c.Return)
+	loans.POST("/:id/renew", c.Renew)
+}
+
+func registerFineRoutes(group *gin.RouterGroup, c *controller.FineController) {
+	if c == nil {
+		return
+	}
+	group.GET("/members/:id/fines", c.GetMemberFines)
+	loans := group.Group("/loans/:id/fine")
+	loans.GET("", c.GetLoanFine)
+	loans.POST("/transactions", c.RecordTransaction)
+}
+
+func registerReservationRoutes(group *gin.RouterGroup, c *controller.ReservationController) {
+	if c == nil {
+		return
+	}
+	books := group.Group("/books/:id/reservations")
+	books.POST("", c.PlaceHold)
+	books.GET("", c.GetBookQueue)
+	reservations := group.Group("/reservations")
+	reservations.GET("/hold-shelf", c.GetHoldShelf)
+	reservations.GET("/:id", c.GetById)
+	reservations.POST("/:id/cancel", c.Cancel)
+}
+
+func registerMemberRoutes(group *gin.RouterGroup, c *controller.MemberController) {
+	if c == nil {
+		return
+	}
+	members := group.Group("/members")
+	members.POST("", c.Create)
+	members.GET("", c.GetAll)
+	members.GET("/email/:email", c.GetByEmail)
+	members.GET("/:id", c.GetById)
+	members.GET("/:id/detail", c.GetDetail)
+	members.PUT("/:id", c.Update)
+	members.DELETE("/:id", c.Delete)
+	members.POST("/:id/activate", c.Activate)
+	members.POST("/:id/deactivate", c.Deactivate)
+	members.POST("/:id/restore", c.Restore)
+}
+
+func registerMembershipCategoryRoutes(group *gin.RouterGroup, c *controller.MembershipCategoryController) {
+	if c == nil {
+		return
+	}
+	categories := group.Group("/membership-categories")
+	categories.POST("", c.Create)
+	categories.GET("", c.GetAll)
+	categories.GET("/:name", c.GetByName)
+	categories.PUT("/:name", c.Update)
+}
+
+func registerSearchRoutes(group *gin.RouterGroup, c *controller.SearchController) {
+	if c == nil {
+		return
+	}
+	group.GET("/search", c.Search)
 }
@@ -3,15 +3,23 @@ package app_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"library/internal/application"
+	"library/internal/domain/model"
 	"library/internal/domain/service"
 	"library/internal/infrastructure/app"
 	"library/internal/infrastructure/controller"
+	"library/internal/infrastructure/health"
+	"library/internal/infrastructure/metrics"
+	"library/internal/test/builder"
 	mockAuthorRepo "library/internal/test/mock"
 )
 
@@ -21,7 +29,7 @@ func TestGivenAuthorControllerWhenInitHandlersThenSetOnHandlers(t *testing.T) {
 	useCase := application.NewAuthorUseCase(svc)
 	ctrl := controller.NewAuthorController(useCase)
 
-	h := app.NewHandlers(ctrl)
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	assert.Equal(t, ctrl, h.Author)
 }
@@ -34,7 +42,7 @@ func TestGivenAuthorControllerWhenPOSTAuthorsThenReturnBadRequest(t *testing.T)
 	useCase := application.NewAuthorUseCase(svc)
 	ctrl := controller.NewAuthorController(useCase)
 
-	h := app.NewHandlers(ctrl)
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	app.RegisterRoutes(r, h)
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/authors", nil)
 	rr := httptest.NewRecorder()
@@ -48,7 +56,7 @@ func TestGivenNoAuthorControllerWhenGETAuthorsThenReturnNotFound(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 
-	h := app.NewHandlers(nil)
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	app.RegisterRoutes(r, h)
 	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/authors", nil)
 	rr := httptest.NewRecorder()
@@ -61,7 +69,7 @@ func TestGivenNoAuthorControllerWhenGETAuthorByIDThenReturnNotFound(t *testing.T
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 
-	h := app.NewHandlers(nil)
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	app.RegisterRoutes(r, h)
 	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/authors/123", nil)
 	rr := httptest.NewRecorder()
@@ -74,7 +82,7 @@ func TestGivenNoAuthorControllerWhenPUTAuthorByIDThenReturnNotFound(t *testing.T
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 
-	h := app.NewHandlers(nil)
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	app.RegisterRoutes(r, h)
 	req, _ := http.NewRequest(http.MethodPut, "/api/v1/library/authors/123", nil)
 	rr := httptest.NewRecorder()
@@ -82,3 +90,374 @@ func TestGivenNoAuthorControllerWhenPUTAuthorByIDThenReturnNotFound(t *testing.T
 
 	assert.Equal(t, http.StatusNotFound, rr.Code)
 }
+
+func TestGivenBookControllerWhenPOSTBooksThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	mockRepo := new(mockAuthorRepo.BookRepoMock)
+	ctrl := controller.NewBookController(application.NewBookUseCase(service.NewBookService(mockRepo)))
+
+	h := app.NewHandlers(nil, ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/books", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenNoBookControllerWhenDELETEBookByIDThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/library/books/123", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenBookAuthorControllerWhenPOSTBookAuthorsWithInvalidIDThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewBookAuthorController(application.NewBookAuthorUseCase(service.NewBookAuthorService(
+		new(mockAuthorRepo.BookAuthorRepoMock), new(mockAuthorRepo.BookRepoMock), new(mockAuthorRepo.AuthorRepoMock))))
+
+	h := app.NewHandlers(nil, nil, ctrl, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/books/123/authors", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenNoBookAuthorControllerWhenGETAuthorBooksThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/authors/123/books", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenBookCopyControllerWhenRetireInvalidCopyIDThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewBookCopyController(application.NewBookCopyUseCase(service.NewBookCopyService(
+		mockAuthorRepo.TransactionMock{}, new(mockAuthorRepo.BookCopyRepoMock), new(mockAuthorRepo.BookRepoMock), nil)))
+
+	h := app.NewHandlers(nil, nil, nil, ctrl, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/copies/123/retire", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenNoBookCopyControllerWhenGETCopyByBarcodeThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/copies/barcode/LIB-0001", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenLoanControllerWhenPOSTCheckoutWithoutBodyThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewLoanController(application.NewLoanUseCase(service.NewLoanService(
+		new(mockAuthorRepo.TransactionMock), new(mockAuthorRepo.LoanRepoMock),
+		new(mockAuthorRepo.BookCopyRepoMock), new(mockAuthorRepo.MemberRepoMock), new(mockAuthorRepo.ReservationRepoMock),
+		service.CategoryFinePolicy{}, service.DefaultLoanPolicy())))
+
+	h := app.NewHandlers(nil, nil, nil, nil, ctrl, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/loans/checkout", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenNoLoanControllerWhenPOSTReturnThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/loans/return", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenFineControllerWhenGETMemberFinesWithInvalidIDThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewFineController(application.NewFineUseCase(service.NewFineService(
+		new(mockAuthorRepo.TransactionMock), new(mockAuthorRepo.LoanRepoMock), new(mockAuthorRepo.MemberRepoMock),
+		new(mockAuthorRepo.FineTransactionRepoMock), service.CategoryFinePolicy{})))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, ctrl, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/members/123/fines", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenNoFineControllerWhenPOSTFineTransactionThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/loans/"+uuid.NewString()+"/fine/transactions", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenReservationControllerWhenCancelInvalidIDThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewReservationController(application.NewReservationUseCase(service.NewReservationService(
+		new(mockAuthorRepo.TransactionMock), new(mockAuthorRepo.ReservationRepoMock), new(mockAuthorRepo.BookRepoMock),
+		new(mockAuthorRepo.MemberRepoMock), new(mockAuthorRepo.BookCopyRepoMock), service.DefaultLoanPolicy())))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, ctrl, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/reservations/123/cancel", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGivenReservationControllerWhenGETHoldShelfThenRouteBeforeReservationID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	reservations := new(mockAuthorRepo.ReservationRepoMock)
+	reservations.On("FindHoldShelf").Return([]model.Reservation{}, nil)
+	ctrl := controller.NewReservationController(application.NewReservationUseCase(service.NewReservationService(
+		new(mockAuthorRepo.TransactionMock), reservations, new(mockAuthorRepo.BookRepoMock),
+		new(mockAuthorRepo.MemberRepoMock), new(mockAuthorRepo.BookCopyRepoMock), service.DefaultLoanPolicy())))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, ctrl, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/reservations/hold-shelf", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	reservations.AssertExpectations(t)
+}
+
+func TestGivenNoReservationControllerWhenGETBookReservationsThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/books/"+uuid.NewString()+"/reservations", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenMemberControllerWhenGETByEmailThenRouteBeforeMemberID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	members := new(mockAuthorRepo.MemberRepoMock)
+	members.On("FindByEmail", "ada@example.com").Return((*model.Member)(nil), model.ErrMemberNotFound)
+	ctrl := controller.NewMemberController(application.NewMemberUseCase(
+		service.NewMemberService(members, nil, nil, nil, nil)))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, ctrl, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/members/email/ada@example.com", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	members.AssertExpectations(t)
+}
+
+func TestGivenNoMemberControllerWhenPOSTMembersThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/members", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenMembershipCategoryControllerWhenGETByNameThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	categories := new(mockAuthorRepo.MembershipCategoryRepoMock)
+	categories.On("FindByName", "vip").Return((*model.MembershipCategory)(nil), model.ErrMembershipCategoryNotFound)
+	ctrl := controller.NewMembershipCategoryController(application.NewMembershipCategoryUseCase(
+		service.NewMembershipCategoryService(categories)))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, ctrl, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/membership-categories/vip", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	categories.AssertExpectations(t)
+}
+
+func TestGivenNoMembershipCategoryControllerWhenGETCategoriesThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/membership-categories", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGivenAuthorControllerWhenDELETELinkedAuthorThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	authors := new(mockAuthorRepo.AuthorRepoMock)
+	id := uuid.New()
+	authors.On("Delete", id, false).Return(model.ErrAuthorHasBooks)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(authors)))
+
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/library/authors/"+id.String(), nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	authors.AssertExpectations(t)
+}
+
+func TestGivenAuthorControllerWhenPOSTRestoreThenReturnAuthor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	authors := new(mockAuthorRepo.AuthorRepoMock)
+	author := builder.NewAuthorBuilder().Build()
+	authors.On("Restore", author.ID).Return(author, nil)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(authors)))
+
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/library/authors/"+author.ID.String()+"/restore", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	authors.AssertExpectations(t)
+}
+
+func TestGivenAuthorControllerWhenPATCHAuthorThenReturnPatchedAuthor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	authors := new(mockAuthorRepo.AuthorRepoMock)
+	author := builder.NewAuthorBuilder().Build()
+	authors.On("Patch", author.ID, mock.AnythingOfType("*model.AuthorPatch")).Return(author, nil)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(authors)))
+
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodPatch, "/api/v1/library/authors/"+author.ID.String(), strings.NewReader(`{"bio":"new"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	authors.AssertExpectations(t)
+}
+
+func TestGivenAuthorControllerWhenGETUnknownAuthorThenReturnProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	authors := new(mockAuthorRepo.AuthorRepoMock)
+	id := uuid.New()
+	authors.On("FindById", id).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(authors)))
+
+	h := app.NewHandlers(ctrl, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/authors/"+id.String(), nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), `"instance":"/api/v1/library/authors/`+id.String()+`"`)
+}
+
+func TestGivenSearchControllerWhenGETSearchWithoutQueryThenReturnProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewSearchController(application.NewSearchUseCase(service.NewSearchService(new(mockAuthorRepo.SearchRepoMock))))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, ctrl, nil)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/library/search", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"invalid_search_query"`)
+}
+
+func TestGivenHealthControllerWhenGETHealthLiveThenReturnUp(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := controller.NewHealthController(health.NewReadiness(time.Second))
+
+	h := app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ctrl)
+	app.RegisterRoutes(r, h)
+	req, _ := http.NewRequest(http.MethodGet, "/health/live", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status":"up"}`, rr.Body.String())
+}
+
+func TestGivenMetricsWhenGETMetricsThenExposeRequestsByRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	app.RegisterMetrics(r, metrics.New())
+	app.RegisterRoutes(r, app.NewHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `library_http_requests_total{method="GET",route="/ping",status="200"} 1`)
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Worker is a background job that runs until its context is canceled.
+type Worker interface {
+	Run(ctx context.Context)
+}
+
+// Server serves HTTP and runs the background workers until its context is
+// done, then stops everything in order: the server stops accepting
+// connections and drains in-flight requests, the workers are stopped and
+// waited for, and finally the closers, such as the database pool, are closed.
+// Draining and stopping the workers share the shutdown timeout.
+type Server struct {
+	HTTP            *http.Server
+	Workers         []Worker
+	Closers         []io.Closer
+	ShutdownTimeout time.Duration
+}
+
+// Run blocks until ctx is done or the server fails, then shuts down. It
+// returns nil after a clean shutdown.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.HTTP.Addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", s.HTTP.Addr, err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve is Run on a listener that is already open.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	workerCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	for _, worker := range s.Workers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			worker.Run(workerCtx)
+		}()
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		slog.Info("HTTP server started", "address", listener.Addr().String())
+		served <- s.HTTP.Serve(listener)
+	}()
+
+	var errs []error
+	select {
+	case err := <-served:
+		errs = append(errs, fmt.Errorf("serve HTTP: %w", err))
+	case <-ctx.Done():
+		slog.Info("Shutting down", "timeout", s.ShutdownTimeout)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.ShutdownTimeout)
+	defer cancel()
+	if err := s.HTTP.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("drain HTTP requests: %w", err))
+	} else {
+		slog.Info("HTTP server stopped")
+	}
+
+	stopWorkers()
+	stopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		slog.Info("Background workers stopped")
+	case <-shutdownCtx.Done():
+		errs = append(errs, errors.New("stop background workers: shutdown timeout exceeded"))
+	}
+
+	for _, closer := range s.Closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
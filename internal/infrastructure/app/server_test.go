@@ -0,0 +1,120 @@
+package app_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"library/internal/infrastructure/app"
+)
+
+// events records the order in which the parts of a server stop.
+type events struct {
+	mu   sync.Mutex
+	list []string
+}
+
+func (e *events) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, event)
+}
+
+func (e *events) all() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string{}, e.list...)
+}
+
+type workerFunc func(ctx context.Context)
+
+func (f workerFunc) Run(ctx context.Context) { f(ctx) }
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+func startServer(t *testing.T, server *app.Server) (string, context.CancelFunc, <-chan error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx, listener) }()
+	return "http://" + listener.Addr().String(), cancel, done
+}
+
+func TestGivenRequestInFlightWhenShutdownThenDrainItBeforeStoppingWorkersAndClosing(t *testing.T) {
+	log := &events{}
+	started, release := make(chan struct{}), make(chan struct{})
+	server := &app.Server{
+		HTTP: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			close(started)
+			<-release
+			log.add("request")
+			w.WriteHeader(http.StatusNoContent)
+		})},
+		Workers: []app.Worker{workerFunc(func(ctx context.Context) {
+			<-ctx.Done()
+			log.add("worker")
+		})},
+		Closers: []io.Closer{closerFunc(func() error {
+			log.add("db")
+			return nil
+		})},
+		ShutdownTimeout: time.Second,
+	}
+	url, cancel, done := startServer(t, server)
+
+	responses := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+			responses <- resp.StatusCode
+		}
+	}()
+	<-started
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	assert.Equal(t, http.StatusNoContent, <-responses)
+	assert.NoError(t, <-done)
+	assert.Equal(t, []string{"request", "worker", "db"}, log.all())
+}
+
+func TestGivenRequestOutlivingTimeoutWhenShutdownThenReportItAndStillClose(t *testing.T) {
+	closed := false
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	server := &app.Server{
+		HTTP: &http.Server{Handler: http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			close(started)
+			<-release
+		})},
+		Closers: []io.Closer{closerFunc(func() error {
+			closed = true
+			return nil
+		})},
+		ShutdownTimeout: 50 * time.Millisecond,
+	}
+	url, cancel, done := startServer(t, server)
+
+	go func() {
+		if resp, err := http.Get(url); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+	cancel()
+
+	assert.ErrorContains(t, <-done, "drain HTTP requests")
+	assert.True(t, closed)
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"library/internal/domain/service"
+	"library/internal/infrastructure/logging"
+	"library/internal/infrastructure/tracing"
+)
+
+// redacted replaces the value of secret settings when the config is printed.
+const redacted = "****"
+
+// Config is the whole configuration of the service, loaded once at start up
+// by LoadConfig and handed to StartApp.
+type Config struct {
+	Server            ServerConfig
+	DB                DBConfig
+	LoanPolicy        service.LoanPolicy
+	HoldSweepInterval time.Duration
+	Tracing           tracing.Options
+	Log               logging.Options
+
+	// settings keeps every resolved key with its source for String.
+	settings map[string]setting
+}
+
+// LoadConfig reads the configuration from, in order of precedence, the
+// environment, a .env file in the working directory and the YAML or TOML file
+// named by CONFIG_FILE. Both files are optional. Every invalid or unknown
+// setting is reported in the returned error. Besides the server, database,
+// loan, tracing and logging settings it reads:
+//
+//	HOLD_SWEEP_INTERVAL   how often expired holds are released (default 5m)
+func LoadConfig() (Config, error) {
+	if err := loadDotEnv(); err != nil {
+		return Config{}, err
+	}
+	file, err := readConfigFile(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return Config{}, err
+	}
+
+	s := newSettings(lookupEnv, file)
+	cfg := Config{
+		Server:            loadServerConfig(s),
+		DB:                loadDBConfig(s),
+		LoanPolicy:        loadLoanPolicy(s),
+		HoldSweepInterval: s.duration("HOLD_SWEEP_INTERVAL", 5*time.Minute),
+		Tracing:           loadTracingOptions(s),
+		Log:               loadLogOptions(s),
+	}
+	s.unused()
+	cfg.settings = s.resolved
+
+	if len(s.errs) > 0 {
+		return Config{}, fmt.Errorf("invalid configuration:\n%w", errors.Join(s.errs...))
+	}
+	return cfg, nil
+}
+
+// String lists every setting as KEY=value with its source, secrets redacted.
+func (c Config) String() string {
+	var b strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(c.settings)) {
+		fmt.Fprintf(&b, "%s=%s (%s)\n", key, c.value(key), c.settings[key].source)
+	}
+	return b.String()
+}
+
+// LogValue logs the settings as a group, secrets redacted.
+func (c Config) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(c.settings))
+	for _, key := range slices.Sorted(maps.Keys(c.settings)) {
+		attrs = append(attrs, slog.String(key, c.value(key)))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func (c Config) value(key string) string {
+	value := c.settings[key].value
+	if value != "" && isSecret(key) {
+		return redacted
+	}
+	return value
+}
+
+func isSecret(key string) bool {
+	for _, word := range []string{"PASSWORD", "SECRET", "TOKEN"} {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// readConfigFile reads a .yaml, .yml or .toml file into settings. Nested
+// tables become keys joined by underscores, so db.host sets DB_HOST, and
+// lists become comma separated values. An empty path reads nothing.
+func readConfigFile(path string) (map[string]fileSetting, error) {
+	settings := map[string]fileSetting{}
+	if path == "" {
+		return settings, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	var tree map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &tree)
+	case ".toml":
+		err = toml.Unmarshal(content, &tree)
+	default:
+		return nil, fmt.Errorf("config file %s: unsupported format %q, use .yaml, .yml or .toml", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := flatten(settings, "", tree); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	return settings, nil
+}
+
+func flatten(settings map[string]fileSetting, prefix string, tree map[string]any) error {
+	for name, value := range tree {
+		path := prefix + name
+		if nested, ok := value.(map[string]any); ok {
+			if err := flatten(settings, path+".", nested); err != nil {
+				return err
+			}
+			continue
+		}
+		text, err := scalar(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		key := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(path))
+		if previous, ok := settings[key]; ok {
+			return fmt.Errorf("%s and %s both set %s", previous.path, path, key)
+		}
+		settings[key] = fileSetting{path: path, value: text}
+	}
+	return nil
+}
+
+func scalar(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			text, err := scalar(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = text
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+}
@@ -0,0 +1,143 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"library/internal/infrastructure/config"
+)
+
+func writeConfigFile(t *testing.T, name, content string) {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv("CONFIG_FILE", path)
+}
+
+func TestGivenYAMLFileWhenLoadConfigThenEnvironmentTakesPrecedence(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("DB_HOST", "")
+	t.Setenv("PORT", "9090")
+	writeConfigFile(t, "library.yaml", `
+port: 8081
+db:
+  host: db.internal
+  max_open_conns: 20
+hold_sweep_interval: 1m
+`)
+
+	cfg, err := config.LoadConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, "db.internal", cfg.DB.Host)
+	assert.Equal(t, 20, cfg.DB.MaxOpenConns)
+	assert.Equal(t, time.Minute, cfg.HoldSweepInterval)
+	assert.Contains(t, cfg.String(), "DB_HOST=db.internal (file)")
+	assert.Contains(t, cfg.String(), "PORT=9090 (env)")
+	assert.Contains(t, cfg.String(), "DB_SCHEMA=library (default)")
+}
+
+func TestGivenTOMLFileWhenLoadConfigThenReadNestedTables(t *testing.T) {
+	setDBEnv(t)
+	writeConfigFile(t, "library.toml", `
+[loan]
+renewal_max_overdue_days = 3
+
+[hold]
+pickup_days = 5
+
+[tracing]
+sample_ratio = 0.5
+`)
+
+	cfg, err := config.LoadConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.LoanPolicy.RenewalMaxOverdueDays)
+	assert.Equal(t, 5, cfg.LoanPolicy.HoldPickupDays)
+	assert.Equal(t, 0.5, cfg.Tracing.SampleRatio)
+	assert.Contains(t, cfg.String(), "HOLD_PICKUP_DAYS=5 (file)")
+}
+
+func TestGivenCategoryTermsInFileWhenLoadConfigThenReportThemUnknown(t *testing.T) {
+	setDBEnv(t)
+	writeConfigFile(t, "library.yaml", `
+loan:
+  period_days: 21
+fine:
+  daily_rate_cents: 50
+`)
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, "loan.period_days: unknown setting in the config file")
+	assert.ErrorContains(t, err, "fine.daily_rate_cents: unknown setting in the config file")
+}
+
+func TestGivenUnknownAndInvalidSettingsWhenLoadConfigThenReportEveryOne(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("PORT", "0")
+	t.Setenv("HOLD_PICKUP_DAYS", "two days")
+	writeConfigFile(t, "library.yaml", `
+db:
+  hots: db.internal
+`)
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, "db.hots: unknown setting in the config file")
+	assert.ErrorContains(t, err, "PORT:")
+	assert.ErrorContains(t, err, "HOLD_PICKUP_DAYS:")
+}
+
+func TestGivenUnsupportedFileWhenLoadConfigThenFail(t *testing.T) {
+	setDBEnv(t)
+	writeConfigFile(t, "library.json", `{}`)
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, "unsupported format")
+}
+
+func TestGivenConfigWhenStringThenRedactSecrets(t *testing.T) {
+	setDBEnv(t)
+
+	cfg, err := config.LoadConfig()
+
+	require.NoError(t, err)
+	assert.Contains(t, cfg.String(), "DB_PASSWORD=**** (env)")
+	assert.NotContains(t, cfg.String(), "it's secret")
+	for _, attr := range cfg.LogValue().Group() {
+		if attr.Key == "DB_PASSWORD" {
+			assert.Equal(t, "****", attr.Value.String())
+		}
+	}
+}
+
+func TestGivenInvalidTracingSettingsWhenLoadConfigThenReportEveryOne(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("TRACING_EXPORTER", "otlp")
+	t.Setenv("TRACING_OTLP_ENDPOINT", "collector:4318")
+	t.Setenv("TRACING_SAMPLE_RATIO", "1.5")
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, "TRACING_OTLP_ENDPOINT:")
+	assert.ErrorContains(t, err, "TRACING_SAMPLE_RATIO:")
+}
+
+func TestGivenInvalidLogSettingsWhenLoadConfigThenReportEveryOne(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("LOG_LEVEL", "loud")
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, "LOG_FORMAT:")
+	assert.ErrorContains(t, err, "LOG_LEVEL:")
+}
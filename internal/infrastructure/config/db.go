@@ -1,34 +1,151 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
-func ConnectDB() *gorm.DB {
-	LoadEnv()
+// migrationSchema is the schema every migration sets on its search_path.
+const migrationSchema = "library"
 
-	host := MustGetEnv("DB_HOST")
-	user := MustGetEnv("DB_USER")
-	password := MustGetEnv("DB_PASSWORD")
-	dbname := MustGetEnv("DB_NAME")
-	port := MustGetEnv("DB_PORT")
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
 
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=America/Bogota",
-		host, user, password, dbname, port)
+// DBConfig is everything needed to open the connection pool.
+type DBConfig struct {
+	Host             string
+	Port             int
+	User             string
+	Password         string
+	Name             string
+	SSLMode          string
+	Schema           string
+	TimeZone         string
+	ApplicationName  string
+	MaxOpenConns     int
+	MaxIdleConns     int
+	ConnMaxLifetime  time.Duration
+	ConnectTimeout   time.Duration
+	StatementTimeout time.Duration
+}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+// loadDBConfig reads the database settings:
+//
+//	DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME   required
+//	DB_SSLMODE             disable, allow, prefer, require, verify-ca or verify-full (default prefer)
+//	DB_SCHEMA              schema put on the search_path, only library, the one the migrations use (default library)
+//	DB_TIMEZONE            session time zone, an IANA name (default America/Bogota)
+//	DB_APPLICATION_NAME    name shown in pg_stat_activity (default library-api)
+//	DB_MAX_OPEN_CONNS      pool size (default 10)
+//	DB_MAX_IDLE_CONNS      idle connections kept, at most DB_MAX_OPEN_CONNS (default 5)
+//	DB_CONN_MAX_LIFETIME   age after which a connection is replaced (default 30m)
+//	DB_CONNECT_TIMEOUT     time allowed to connect, at least 1s (default 5s)
+//	DB_STATEMENT_TIMEOUT   longest statement allowed, 0 disables it (default 0)
+func loadDBConfig(s *settings) DBConfig {
+	cfg := DBConfig{
+		Host:            s.required("DB_HOST"),
+		User:            s.required("DB_USER"),
+		Password:        s.required("DB_PASSWORD"),
+		Name:            s.required("DB_NAME"),
+		SSLMode:         s.get("DB_SSLMODE", "prefer"),
+		Schema:          s.get("DB_SCHEMA", migrationSchema),
+		TimeZone:        s.get("DB_TIMEZONE", "America/Bogota"),
+		ApplicationName: s.get("DB_APPLICATION_NAME", "library-api"),
+	}
 
+	if port := s.required("DB_PORT"); port != "" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			s.fail(fmt.Errorf("DB_PORT: %q must be a port number between 1 and 65535", port))
+		}
+		cfg.Port = n
+	}
+	if !slices.Contains(sslModes, cfg.SSLMode) {
+		s.fail(fmt.Errorf("DB_SSLMODE: %q must be one of %s", cfg.SSLMode, strings.Join(sslModes, ", ")))
+	}
+	if cfg.Schema != migrationSchema {
+		s.fail(fmt.Errorf("DB_SCHEMA: %q is not supported, the migrations create the tables in the %s schema",
+			cfg.Schema, migrationSchema))
+	}
+	if _, err := time.LoadLocation(cfg.TimeZone); err != nil {
+		s.fail(fmt.Errorf("DB_TIMEZONE: %q is not a known time zone", cfg.TimeZone))
+	}
+
+	cfg.MaxOpenConns = s.int("DB_MAX_OPEN_CONNS", 10)
+	if cfg.MaxOpenConns == 0 {
+		s.fail(errors.New("DB_MAX_OPEN_CONNS: must be greater than zero"))
+	}
+	cfg.MaxIdleConns = s.int("DB_MAX_IDLE_CONNS", 5)
+	if cfg.MaxOpenConns > 0 && cfg.MaxIdleConns > cfg.MaxOpenConns {
+		s.fail(fmt.Errorf("DB_MAX_IDLE_CONNS: %d must not exceed DB_MAX_OPEN_CONNS (%d)", cfg.MaxIdleConns, cfg.MaxOpenConns))
+	}
+	cfg.ConnMaxLifetime = s.duration("DB_CONN_MAX_LIFETIME", 30*time.Minute)
+	cfg.ConnectTimeout = s.duration("DB_CONNECT_TIMEOUT", 5*time.Second)
+	if cfg.ConnectTimeout < time.Second {
+		s.fail(fmt.Errorf("DB_CONNECT_TIMEOUT: %s must be at least 1s", cfg.ConnectTimeout))
+	}
+	cfg.StatementTimeout = s.durationOrZero("DB_STATEMENT_TIMEOUT")
+	return cfg
+}
+
+// DSN renders the configuration as a libpq key/value connection string. The
+// schema, time zone and statement timeout are sent as session parameters.
+func (c DBConfig) DSN() string {
+	params := [][2]string{
+		{"host", c.Host},
+		{"port", strconv.Itoa(c.Port)},
+		{"user", c.User},
+		{"password", c.Password},
+		{"dbname", c.Name},
+		{"sslmode", c.SSLMode},
+		{"search_path", c.Schema},
+		{"TimeZone", c.TimeZone},
+		{"application_name", c.ApplicationName},
+		{"connect_timeout", strconv.Itoa(int(c.ConnectTimeout / time.Second))},
+	}
+	if c.StatementTimeout > 0 {
+		params = append(params, [2]string{"statement_timeout", strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10)})
+	}
+
+	quote := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	parts := make([]string, len(params))
+	for i, param := range params {
+		parts[i] = param[0] + "='" + quote.Replace(param[1]) + "'"
+	}
+	return strings.Join(parts, " ")
+}
+
+// ConnectDB opens the pool described by cfg and checks that the database
+// answers within the connect timeout.
+func ConnectDB(cfg DBConfig) (*gorm.DB, error) {
+	target := fmt.Sprintf("%s:%d/%s", cfg.Host, cfg.Port, cfg.Name)
+	db, err := gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{DisableAutomaticPing: true})
 	if err != nil {
-		slog.Error("Error trying to connect to the database", "error", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("connect to database %s: %w", target, err)
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("connect to database %s: %w", target, err)
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
-	slog.Info("Connection successful")
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("connect to database %s: %w", target, err)
+	}
 
-	return db
+	slog.Info("Connection successful", "database", target, "schema", cfg.Schema)
+	return db, nil
 }
@@ -0,0 +1,76 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/infrastructure/config"
+)
+
+func setDBEnv(t *testing.T) {
+	t.Setenv("DB_HOST", "db")
+	t.Setenv("DB_PORT", "5432")
+	t.Setenv("DB_USER", "library")
+	t.Setenv("DB_PASSWORD", "it's secret")
+	t.Setenv("DB_NAME", "library")
+}
+
+func TestGivenRequiredSettingsWhenLoadConfigThenApplyDefaults(t *testing.T) {
+	setDBEnv(t)
+
+	cfg, err := config.LoadConfig()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5432, cfg.DB.Port)
+	assert.Equal(t, "prefer", cfg.DB.SSLMode)
+	assert.Equal(t, "library", cfg.DB.Schema)
+	assert.Equal(t, 10, cfg.DB.MaxOpenConns)
+	assert.Equal(t, 5*time.Second, cfg.DB.ConnectTimeout)
+	assert.Zero(t, cfg.DB.StatementTimeout)
+}
+
+func TestGivenInvalidSettingsWhenLoadConfigThenReportEveryOne(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("DB_HOST", "")
+	t.Setenv("DB_PORT", "70000")
+	t.Setenv("DB_SSLMODE", "maybe")
+	t.Setenv("DB_SCHEMA", "library; DROP")
+	t.Setenv("DB_TIMEZONE", "Mars/Olympus")
+	t.Setenv("DB_MAX_OPEN_CONNS", "2")
+	t.Setenv("DB_MAX_IDLE_CONNS", "4")
+	t.Setenv("DB_STATEMENT_TIMEOUT", "-1s")
+
+	_, err := config.LoadConfig()
+
+	for _, key := range []string{
+		"DB_HOST", "DB_PORT", "DB_SSLMODE", "DB_SCHEMA", "DB_TIMEZONE", "DB_MAX_IDLE_CONNS", "DB_STATEMENT_TIMEOUT",
+	} {
+		assert.ErrorContains(t, err, key+":")
+	}
+}
+
+func TestGivenSchemaOtherThanTheMigrationsOneWhenLoadConfigThenFail(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("DB_SCHEMA", "catalog")
+
+	_, err := config.LoadConfig()
+
+	assert.ErrorContains(t, err, `DB_SCHEMA: "catalog" is not supported`)
+}
+
+func TestGivenConfigWhenDSNThenQuoteValuesAndSendSessionSettings(t *testing.T) {
+	setDBEnv(t)
+	t.Setenv("DB_STATEMENT_TIMEOUT", "15s")
+	cfg, err := config.LoadConfig()
+	assert.NoError(t, err)
+
+	dsn := cfg.DB.DSN()
+
+	assert.Contains(t, dsn, `password='it\'s secret'`)
+	assert.Contains(t, dsn, `search_path='library'`)
+	assert.Contains(t, dsn, `TimeZone='America/Bogota'`)
+	assert.Contains(t, dsn, `connect_timeout='5'`)
+	assert.Contains(t, dsn, `statement_timeout='15000'`)
+}
@@ -1,30 +1,133 @@
 package config
 
 import (
-	"log/slog"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
-func LoadEnv() {
-	if err := godotenv.Load(); err != nil {
-		slog.Error("No .env file found, reading environment variables from system", "error", err)
+// Sources of a setting, from the highest precedence to the lowest.
+const (
+	sourceEnv     = "env"
+	sourceFile    = "file"
+	sourceDefault = "default"
+)
+
+// settings resolves configuration keys, named as environment variables, from
+// the environment first, then the config file, then the default of the key.
+// Empty environment variables count as unset.
+// Invalid values are collected rather than returned, so that every problem
+// can be reported at once.
+type settings struct {
+	lookup   func(key string) (string, bool)
+	file     map[string]fileSetting
+	resolved map[string]setting
+	errs     []error
+}
+
+type fileSetting struct {
+	path  string
+	value string
+}
+
+type setting struct {
+	value  string
+	source string
+}
+
+func newSettings(lookup func(string) (string, bool), file map[string]fileSetting) *settings {
+	return &settings{lookup: lookup, file: file, resolved: map[string]setting{}}
+}
+
+// loadDotEnv adds the variables of .env to the environment without
+// overriding those already set. The file is optional.
+func loadDotEnv() error {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf(".env: %w", err)
+	}
+	return nil
+}
+
+func (s *settings) fail(err error) {
+	s.errs = append(s.errs, err)
+}
+
+func (s *settings) get(key, defaultValue string) string {
+	value, source := defaultValue, sourceDefault
+	if v, ok := s.lookup(key); ok && v != "" {
+		value, source = v, sourceEnv
+	} else if v, ok := s.file[key]; ok {
+		value, source = v.value, sourceFile
 	}
+	s.resolved[key] = setting{value: value, source: source}
+	return value
 }
 
-func GetEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+func (s *settings) required(key string) string {
+	value := s.get(key, "")
+	if value == "" {
+		s.fail(fmt.Errorf("%s: is required", key))
 	}
-	return defaultValue
+	return value
 }
 
-func MustGetEnv(key string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+func (s *settings) int(key string, defaultValue int) int {
+	value := s.get(key, "")
+	if value == "" {
+		return defaultValue
 	}
-	slog.Error("environment variable is required but not set", "key", key)
-	os.Exit(1)
-	return ""
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		s.fail(fmt.Errorf("%s: %q must be a non-negative integer", key, value))
+		return defaultValue
+	}
+	return n
+}
+
+func (s *settings) duration(key string, defaultValue time.Duration) time.Duration {
+	value := s.get(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		s.fail(fmt.Errorf("%s: %q must be a positive duration such as 5m", key, value))
+		return defaultValue
+	}
+	return d
+}
+
+func (s *settings) durationOrZero(key string) time.Duration {
+	value := s.get(key, "")
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		s.fail(fmt.Errorf("%s: %q must be a duration such as 30s, or 0 to disable it", key, value))
+		return 0
+	}
+	return d
+}
+
+// unused reports the settings of the config file that no key asked for,
+// which are most likely misspelled.
+func (s *settings) unused() {
+	for _, key := range slices.Sorted(maps.Keys(s.file)) {
+		if _, ok := s.resolved[key]; !ok {
+			s.fail(fmt.Errorf("%s: unknown setting in the config file", s.file[key].path))
+		}
+	}
+}
+
+// lookupEnv is the process environment as a settings lookup.
+func lookupEnv(key string) (string, bool) {
+	return os.LookupEnv(key)
 }
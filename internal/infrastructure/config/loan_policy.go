@@ -0,0 +1,18 @@
+package config
+
+import (
+	"library/internal/domain/service"
+)
+
+// loadLoanPolicy builds the circulation rules shared by every member. The loan
+// limit, period, renewals and fines are set per membership category through
+// the API instead:
+//
+//	LOAN_RENEWAL_MAX_OVERDUE_DAYS   overdue days after which renewal is refused (default 0)
+//	HOLD_PICKUP_DAYS                days a returned copy waits on the hold shelf (default 3)
+func loadLoanPolicy(s *settings) service.LoanPolicy {
+	policy := service.DefaultLoanPolicy()
+	policy.RenewalMaxOverdueDays = s.int("LOAN_RENEWAL_MAX_OVERDUE_DAYS", 0)
+	policy.HoldPickupDays = s.int("HOLD_PICKUP_DAYS", policy.HoldPickupDays)
+	return policy
+}
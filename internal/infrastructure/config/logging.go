@@ -0,0 +1,27 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"library/internal/infrastructure/logging"
+)
+
+// loadLogOptions reads the logging settings:
+//
+//	LOG_FORMAT   json or text (default json)
+//	LOG_LEVEL    debug, info, warn or error (default info)
+func loadLogOptions(s *settings) logging.Options {
+	opts := logging.Options{Format: s.get("LOG_FORMAT", logging.FormatJSON)}
+	if !slices.Contains(logging.Formats, opts.Format) {
+		s.fail(fmt.Errorf("LOG_FORMAT: %q must be one of %s", opts.Format, strings.Join(logging.Formats, ", ")))
+	}
+	level := s.get("LOG_LEVEL", "info")
+	if err := opts.Level.UnmarshalText([]byte(level)); err != nil {
+		s.fail(fmt.Errorf("LOG_LEVEL: %q must be debug, info, warn or error", level))
+		opts.Level = slog.LevelInfo
+	}
+	return opts
+}
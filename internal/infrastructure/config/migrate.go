@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"library/internal/infrastructure/migrator"
+	"library/migration"
+)
+
+var errMigrateUsage = errors.New("usage: migrate up | down [steps] | status | baseline <version>")
+
+// RunMigrate runs the migrate command against the database of cfg: up applies
+// every pending migration, down reverts the last one, or the last steps,
+// status lists them all and baseline records the migrations up to version as
+// applied without running them.
+func RunMigrate(cfg DBConfig, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+	steps, version := 1, 0
+	switch {
+	case args[0] == "down" && len(args) == 2:
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return errMigrateUsage
+		}
+		steps = n
+	case args[0] == "baseline":
+		if len(args) != 2 {
+			return errMigrateUsage
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return errMigrateUsage
+		}
+		version = n
+	case len(args) != 1:
+		return errMigrateUsage
+	}
+
+	db, err := ConnectDB(cfg)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	m, err := migrator.New(db, migration.Files)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		fmt.Printf("%d migration(s) applied\n", applied)
+		return err
+	case "down":
+		reverted, err := m.Down(ctx, steps)
+		fmt.Printf("%d migration(s) reverted\n", reverted)
+		return err
+	case "baseline":
+		recorded, err := m.Baseline(ctx, version)
+		fmt.Printf("%d migration(s) recorded as applied\n", recorded)
+		return err
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%03d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return errMigrateUsage
+	}
+}
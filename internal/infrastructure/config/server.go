@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServerConfig sets how the HTTP server listens and shuts down.
+type ServerConfig struct {
+	Port               int
+	ReadHeaderTimeout  time.Duration
+	ReadTimeout        time.Duration
+	WriteTimeout       time.Duration
+	IdleTimeout        time.Duration
+	ShutdownTimeout    time.Duration
+	HealthCheckTimeout time.Duration
+	AdminToken         string
+}
+
+// loadServerConfig reads the HTTP server settings:
+//
+//	PORT                        HTTP port (default 8080)
+//	HTTP_READ_HEADER_TIMEOUT    time allowed to read the request headers (default 5s)
+//	HTTP_READ_TIMEOUT           time allowed to read a whole request (default 15s)
+//	HTTP_WRITE_TIMEOUT          time allowed to write a response (default 30s)
+//	HTTP_IDLE_TIMEOUT           how long a keep-alive connection waits for the next request (default 60s)
+//	SHUTDOWN_TIMEOUT            time in-flight requests and workers get to finish on SIGINT or SIGTERM (default 30s)
+//	HEALTH_CHECK_TIMEOUT        time each readiness check gets before it counts as down (default 2s)
+//	ADMIN_TOKEN                 bearer token of administrators, who may read deleted records (default none)
+func loadServerConfig(s *settings) ServerConfig {
+	cfg := ServerConfig{
+		Port:               s.int("PORT", 8080),
+		ReadHeaderTimeout:  s.duration("HTTP_READ_HEADER_TIMEOUT", 5*time.Second),
+		ReadTimeout:        s.duration("HTTP_READ_TIMEOUT", 15*time.Second),
+		WriteTimeout:       s.duration("HTTP_WRITE_TIMEOUT", 30*time.Second),
+		IdleTimeout:        s.duration("HTTP_IDLE_TIMEOUT", 60*time.Second),
+		ShutdownTimeout:    s.duration("SHUTDOWN_TIMEOUT", 30*time.Second),
+		HealthCheckTimeout: s.duration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+		AdminToken:         s.get("ADMIN_TOKEN", ""),
+	}
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		s.fail(fmt.Errorf("PORT: %d must be a port number between 1 and 65535", cfg.Port))
+	}
+	if cfg.ReadHeaderTimeout > cfg.ReadTimeout {
+		s.fail(fmt.Errorf("HTTP_READ_HEADER_TIMEOUT: %s must not exceed HTTP_READ_TIMEOUT (%s)", cfg.ReadHeaderTimeout, cfg.ReadTimeout))
+	}
+	return cfg
+}
@@ -1,37 +1,174 @@
 package config
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	"library/internal/application"
-	"library/internal/domain/model"
 	"library/internal/domain/service"
 	"library/internal/infrastructure/adapter/repository"
 	"library/internal/infrastructure/app"
 	"library/internal/infrastructure/controller"
+	"library/internal/infrastructure/health"
+	"library/internal/infrastructure/logging"
+	"library/internal/infrastructure/metrics"
+	"library/internal/infrastructure/migrator"
+	"library/internal/infrastructure/tracing"
+	"library/internal/infrastructure/worker"
+	"library/migration"
 )
 
-func StartApp() {
-	db := ConnectDB()
-	if err := db.AutoMigrate(&model.Author{}); err != nil {
-		slog.Error("Failed to migrate database", "error", err)
-		os.Exit(1)
+// StartApp wires the application with cfg and serves HTTP until SIGINT or
+// SIGTERM, then drains in-flight requests, stops the workers and closes the
+// database pool. Database problems are returned before anything starts.
+func StartApp(cfg Config) error {
+	db, err := ConnectDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	migrations, err := migrator.New(db, migration.Files)
+	if err != nil {
+		_ = sqlDB.Close()
+		return err
+	}
+	if err := migrations.Check(context.Background()); err != nil {
+		_ = sqlDB.Close()
+		return fmt.Errorf("refusing to start against an unmigrated database: %w", err)
+	}
+	appMetrics := metrics.New()
+	if err := appMetrics.InstrumentDB(db, cfg.DB.Name); err != nil {
+		_ = sqlDB.Close()
+		return err
+	}
+	if err := tracing.InstrumentDB(db); err != nil {
+		_ = sqlDB.Close()
+		return err
+	}
+	tracer, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		_ = sqlDB.Close()
+		return err
 	}
 
-	authorRepo := repository.NewAuthorRepository(db)
-	authorService := service.NewAuthorService(authorRepo)
-	authorApplication := application.NewAuthorUseCase(authorService)
+	authorRepo := tracing.AuthorPort(repository.NewAuthorRepository(db))
+	authorService := tracing.AuthorService(service.NewAuthorService(authorRepo))
+	authorApplication := tracing.AuthorUseCase(application.NewAuthorUseCase(authorService))
 	authorController := controller.NewAuthorController(authorApplication)
 
-	r := gin.Default()
-	handlers := app.NewHandlers(authorController)
+	bookRepo := repository.NewBookRepository(db)
+	bookService := service.NewBookService(bookRepo)
+	bookApplication := application.NewBookUseCase(bookService)
+	bookController := controller.NewBookController(bookApplication)
+
+	bookAuthorRepo := repository.NewBookAuthorRepository(db)
+	bookAuthorService := service.NewBookAuthorService(bookAuthorRepo, bookRepo, authorRepo)
+	bookAuthorApplication := application.NewBookAuthorUseCase(bookAuthorService)
+	bookAuthorController := controller.NewBookAuthorController(bookAuthorApplication)
+
+	txRepo := repository.NewTransactionRepository(db)
+	reservationRepo := repository.NewReservationRepository(db)
+	bookCopyRepo := repository.NewBookCopyRepository(db)
+	bookCopyService := service.NewBookCopyService(txRepo, bookCopyRepo, bookRepo, reservationRepo)
+	bookCopyApplication := application.NewBookCopyUseCase(bookCopyService)
+	bookCopyController := controller.NewBookCopyController(bookCopyApplication)
+
+	memberRepo := repository.NewMemberRepository(db)
+	loanRepo := repository.NewLoanRepository(db)
+	loanService := service.NewLoanService(
+		txRepo, loanRepo, bookCopyRepo, memberRepo, reservationRepo, service.CategoryFinePolicy{}, cfg.LoanPolicy,
+	)
+	loanApplication := appMetrics.LoanUseCase(application.NewLoanUseCase(loanService))
+	if err := appMetrics.TrackOverdueLoans(loanApplication); err != nil {
+		_ = tracer.Close()
+		_ = sqlDB.Close()
+		return err
+	}
+	loanController := controller.NewLoanController(loanApplication)
+
+	fineTransactionRepo := repository.NewFineTransactionRepository(db)
+	fineService := service.NewFineService(txRepo, loanRepo, memberRepo, fineTransactionRepo, service.CategoryFinePolicy{})
+	fineApplication := application.NewFineUseCase(fineService)
+	fineController := controller.NewFineController(fineApplication)
+
+	reservationService := service.NewReservationService(
+		txRepo, reservationRepo, bookRepo, memberRepo, bookCopyRepo, cfg.LoanPolicy,
+	)
+	reservationApplication := appMetrics.ReservationUseCase(application.NewReservationUseCase(reservationService))
+	reservationController := controller.NewReservationController(reservationApplication)
+
+	categoryRepo := repository.NewMembershipCategoryRepository(db)
+	categoryService := service.NewMembershipCategoryService(categoryRepo)
+	categoryApplication := application.NewMembershipCategoryUseCase(categoryService)
+	categoryController := controller.NewMembershipCategoryController(categoryApplication)
+
+	memberService := service.NewMemberService(memberRepo, categoryRepo, loanRepo, reservationRepo, fineService)
+	memberApplication := application.NewMemberUseCase(memberService)
+	memberController := controller.NewMemberController(memberApplication)
+
+	searchRepo := repository.NewSearchRepository(db)
+	searchService := service.NewSearchService(searchRepo)
+	searchApplication := application.NewSearchUseCase(searchService)
+	searchController := controller.NewSearchController(searchApplication)
+
+	readiness := health.NewReadiness(cfg.Server.HealthCheckTimeout)
+	readiness.Register("database", health.CheckerFunc(sqlDB.PingContext))
+	readiness.Register("migrations", health.CheckerFunc(migrations.Check))
+	healthController := controller.NewHealthController(readiness)
+
+	r := gin.New()
+	r.Use(gin.Recovery(), otelgin.Middleware(cfg.Tracing.ServiceName), logging.Middleware(),
+		controller.AdminAuth(cfg.Server.AdminToken))
+	app.RegisterMetrics(r, appMetrics)
+	handlers := app.NewHandlers(
+		authorController,
+		bookController,
+		bookAuthorController,
+		bookCopyController,
+		loanController,
+		fineController,
+		reservationController,
+		memberController,
+		categoryController,
+		searchController,
+		healthController,
+	)
 	app.RegisterRoutes(r, handlers)
 
-	if err := r.Run(":" + GetEnv("PORT", "8080")); err != nil {
-		slog.Error("Failed to run server", "error", err)
-		os.Exit(1)
+	server := &app.Server{
+		HTTP: &http.Server{
+			Addr:              ":" + strconv.Itoa(cfg.Server.Port),
+			Handler:           r,
+			ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+			ReadTimeout:       cfg.Server.ReadTimeout,
+			WriteTimeout:      cfg.Server.WriteTimeout,
+			IdleTimeout:       cfg.Server.IdleTimeout,
+		},
+		Workers: []app.Worker{
+			worker.NewHoldShelfSweeper(reservationApplication, cfg.HoldSweepInterval),
+		},
+		Closers:         []io.Closer{sqlDB, tracer},
+		ShutdownTimeout: cfg.Server.ShutdownTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := server.Run(ctx); err != nil {
+		return fmt.Errorf("run server: %w", err)
 	}
+	slog.Info("Shutdown complete")
+	return nil
 }
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"library/internal/infrastructure/tracing"
+)
+
+// loadTracingOptions reads the tracing settings:
+//
+//	TRACING_EXPORTER        none, stderr or otlp (default none)
+//	TRACING_OTLP_ENDPOINT   OTLP/HTTP collector URL (default http://localhost:4318)
+//	TRACING_SERVICE_NAME    service.name of the spans (default library-api)
+//	TRACING_SAMPLE_RATIO    share of new traces kept, from 0 to 1 (default 1)
+func loadTracingOptions(s *settings) tracing.Options {
+	opts := tracing.Options{
+		Exporter:     s.get("TRACING_EXPORTER", tracing.ExporterNone),
+		OTLPEndpoint: s.get("TRACING_OTLP_ENDPOINT", "http://localhost:4318"),
+		ServiceName:  s.get("TRACING_SERVICE_NAME", "library-api"),
+		SampleRatio:  1,
+	}
+	if !slices.Contains(tracing.Exporters, opts.Exporter) {
+		s.fail(fmt.Errorf("TRACING_EXPORTER: %q must be one of %s", opts.Exporter, strings.Join(tracing.Exporters, ", ")))
+	}
+	if opts.Exporter == tracing.ExporterOTLP {
+		if u, err := url.Parse(opts.OTLPEndpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			s.fail(fmt.Errorf("TRACING_OTLP_ENDPOINT: %q must be a URL such as http://collector:4318", opts.OTLPEndpoint))
+		}
+	}
+	if value := s.get("TRACING_SAMPLE_RATIO", ""); value != "" {
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			s.fail(fmt.Errorf("TRACING_SAMPLE_RATIO: %q must be a number between 0 and 1", value))
+		} else {
+			opts.SampleRatio = ratio
+		}
+	}
+	return opts
+}
@@ -1,16 +1,43 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 
 	"library/internal/application"
+	"library/internal/domain/domainerror"
 	"library/internal/domain/model"
 )
 
+const mergePatchContentType = "application/merge-patch+json"
+
+var (
+	errInvalidForceFlag  = domainerror.NewValidation("invalid_force_flag", "Invalid force flag")
+	errInvalidMergePatch = domainerror.NewValidation("invalid_merge_patch", "merge patch must be a JSON object")
+)
+
+// authorRequest is the body of POST and PUT /authors. The lengths match the
+// columns of the authors table.
+type authorRequest struct {
+	FirstName string `json:"first_name" binding:"required,notblank,max=200"`
+	LastName  string `json:"last_name" binding:"required,notblank,max=200"`
+	Bio       string `json:"bio" binding:"max=500"`
+}
+
+func (r *authorRequest) toModel() *model.Author {
+	return &model.Author{FirstName: r.FirstName, LastName: r.LastName, Bio: r.Bio}
+}
+
 type AuthorController struct {
 	app application.AuthorUseCaseInterface
 }
@@ -20,17 +47,17 @@ func NewAuthorController(app application.AuthorUseCaseInterface) *AuthorControll
 }
 
 func (c *AuthorController) Create(ctx *gin.Context) {
-	var author model.Author
-
-	if err := ctx.ShouldBindJSON(&author); err != nil {
-		slog.Error("Error trying to convert author", "error", err)
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req authorRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert author", "error", err)
+		ctx.Error(err)
 		return
 	}
 
-	if err := c.app.CreateAuthorUseCase(ctx.Request.Context(), &author); err != nil {
-		slog.Error("Error trying to create author", "error", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	author := req.toModel()
+	if err := c.app.CreateAuthorUseCase(ctx.Request.Context(), author); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to create author", "error", err)
+		ctx.Error(err)
 		return
 	}
 
@@ -38,28 +65,45 @@ func (c *AuthorController) Create(ctx *gin.Context) {
 }
 
 func (c *AuthorController) GetAll(ctx *gin.Context) {
-	authors, err := c.app.GetAuthorsUseCase(ctx.Request.Context())
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := listQuery(ctx, "last_name", "first_name")
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get list parameters", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	page, err := c.app.GetAuthorsUseCase(reqCtx, query)
 	if err != nil {
-		slog.Error("Error trying to find authors", "error", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find authors", "error", err)
+		ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, authors)
+	ctx.JSON(http.StatusOK, newListResponse(ctx, query, page))
 }
 
 func (c *AuthorController) GetById(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		slog.Error("Error trying to get parameter", "error", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid ID"})
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reqCtx, ok := readContext(ctx)
+	if !ok {
 		return
 	}
 
-	author, err := c.app.GetAuthorUseCase(ctx.Request.Context(), id)
+	author, err := c.app.GetAuthorUseCase(reqCtx, id)
 	if err != nil {
-		slog.Error("Error trying to get author", "error", err)
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get author", "error", err)
+		ctx.Error(err)
 		return
 	}
 
@@ -70,23 +114,158 @@ func (c *AuthorController) Update(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		slog.Error("Error trying to get parameter", "error", err)
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req authorRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert author", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	updated, err := c.app.UpdateAuthorUseCase(ctx.Request.Context(), id, req.toModel())
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to update author", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, updated)
+}
+
+// Patch applies a JSON merge patch (RFC 7396) to an author. Fields left out
+// are kept and a null bio clears it.
+func (c *AuthorController) Patch(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	if contentType := ctx.ContentType(); contentType != mergePatchContentType && contentType != gin.MIMEJSON {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to patch author", "content_type", contentType)
+		ctx.Error(errUnsupportedMergePatch)
 		return
 	}
 
-	var patch model.Author
-	if err := ctx.ShouldBindJSON(&patch); err != nil {
-		slog.Error("Error trying to convert author", "error", err)
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var doc map[string]json.RawMessage
+	if err := ctx.ShouldBindJSON(&doc); err != nil || doc == nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert author patch", "error", err)
+		ctx.Error(errInvalidMergePatch)
+		return
+	}
+	patch, err := authorPatchFrom(doc)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert author patch", "error", err)
+		ctx.Error(err)
 		return
 	}
 
-	updated, err := c.app.UpdateAuthorUseCase(ctx.Request.Context(), id, &patch)
+	updated, err := c.app.PatchAuthorUseCase(ctx.Request.Context(), id, patch)
 	if err != nil {
-		slog.Error("Error trying to update author", "error", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to patch author", "error", err)
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, updated)
 }
+
+// authorPatchRequest holds the fields present in a merge patch, checked with
+// the same rules as authorRequest.
+type authorPatchRequest struct {
+	FirstName *string `json:"first_name" binding:"omitempty,notblank,max=200"`
+	LastName  *string `json:"last_name" binding:"omitempty,notblank,max=200"`
+	Bio       *string `json:"bio" binding:"omitempty,max=500"`
+}
+
+// authorPatchFrom maps a merge patch document onto the editable author fields.
+// Only bio may be removed with null; the names are required. Every invalid
+// field is reported, not just the first.
+func authorPatchFrom(doc map[string]json.RawMessage) (*model.AuthorPatch, error) {
+	var req authorPatchRequest
+	var fields []FieldError
+	for _, field := range slices.Sorted(maps.Keys(doc)) {
+		raw := doc[field]
+		var target **string
+		switch field {
+		case "first_name":
+			target = &req.FirstName
+		case "last_name":
+			target = &req.LastName
+		case "bio":
+			target = &req.Bio
+		default:
+			fields = append(fields, FieldError{Field: field, Code: "unknown_field", Message: "cannot be patched"})
+			continue
+		}
+
+		value := ""
+		if string(raw) == "null" {
+			if field != "bio" {
+				fields = append(fields, FieldError{Field: field, Code: "required", Message: "cannot be removed"})
+				continue
+			}
+		} else if err := json.Unmarshal(raw, &value); err != nil {
+			fields = append(fields, FieldError{Field: field, Code: "invalid_type", Message: "must be a string"})
+			continue
+		}
+		*target = &value
+	}
+
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		var validationErrs validator.ValidationErrors
+		if !errors.As(err, &validationErrs) {
+			return nil, err
+		}
+		fields = append(fields, fieldErrorsFrom(validationErrs)...)
+	}
+	if len(fields) > 0 {
+		return nil, newInvalidFields(fields)
+	}
+	return &model.AuthorPatch{FirstName: req.FirstName, LastName: req.LastName, Bio: req.Bio}, nil
+}
+
+// Delete removes an author. Authors credited on books are only deleted when
+// the request sets ?force=true.
+func (c *AuthorController) Delete(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	force, err := strconv.ParseBool(ctx.DefaultQuery("force", "false"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get force parameter", "error", err)
+		ctx.Error(errInvalidForceFlag)
+		return
+	}
+
+	if err := c.app.DeleteAuthorUseCase(ctx.Request.Context(), id, force); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to delete author", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AuthorController) Restore(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	author, err := c.app.RestoreAuthorUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to restore author", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, author)
+}
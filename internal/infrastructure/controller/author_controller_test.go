@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +16,7 @@ import (
 
 	"library/internal/application"
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 	"library/internal/domain/service"
 	"library/internal/infrastructure/controller"
 	"library/internal/test/builder"
@@ -36,7 +38,7 @@ func TestGivenAnAuthorWhenCreateInControllerThenReturnStatusCreated(t *testing.T
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
 
-	ctrl.Create(c)
+	serve(c, ctrl.Create)
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -59,7 +61,7 @@ func TestGivenWrongAuthorWhenCreateInControllerThenReturnError(t *testing.T) {
 
 	c.Request = req
 
-	ctrl.Create(c)
+	serve(c, ctrl.Create)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -75,9 +77,7 @@ func TestGivenAuthorWheUpdateInDataBaseInControllerThenReturnNilError(t *testing
 	patch := builder.UpdateAuthorBuilder().Build()
 	updated := *author
 	updated.Bio = patch.Bio
-	mockRepo.On("FindById", author.ID).Return(author, nil).Once()
-	mockRepo.On("Update", author.ID, mock.AnythingOfType("*model.Author")).Return(author, nil).Once()
-	mockRepo.On("FindById", author.ID).Return(&updated, nil).Once()
+	mockRepo.On("Update", author.ID, mock.AnythingOfType("*model.Author")).Return(&updated, nil).Once()
 	body, _ := json.Marshal(patch)
 	req, _ := http.NewRequest("PUT", "/authors/"+author.ID.String(), bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -86,7 +86,7 @@ func TestGivenAuthorWheUpdateInDataBaseInControllerThenReturnNilError(t *testing
 	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
 	c.Request = req
 
-	ctrl.Update(c)
+	serve(c, ctrl.Update)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -100,8 +100,7 @@ func TestGivenWrongAuthorWhenUpdateInDataBaseInControllerThenReturnError(t *test
 	ctrl := controller.NewAuthorController(app)
 	author := builder.NewAuthorBuilder().Build()
 	patch := builder.UpdateAuthorBuilder().Build()
-	mockRepo.On("FindById", author.ID).Return(author, nil).Once()
-	mockRepo.On("Update", author.ID, mock.AnythingOfType("*model.Author")).Return(&model.Author{}, errors.New("db error")).Once()
+	mockRepo.On("Update", author.ID, mock.AnythingOfType("*model.Author")).Return((*model.Author)(nil), errors.New("db error")).Once()
 
 	body, _ := json.Marshal(patch)
 	req, _ := http.NewRequest("PUT", "/authors/"+author.ID.String(), bytes.NewBuffer(body))
@@ -111,7 +110,7 @@ func TestGivenWrongAuthorWhenUpdateInDataBaseInControllerThenReturnError(t *test
 	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
 	c.Request = req
 
-	ctrl.Update(c)
+	serve(c, ctrl.Update)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -129,14 +128,14 @@ func TestGivenAuthorsInDBWhenGetAuthorsInControllerThenReturnList(t *testing.T)
 		firstAuthor,
 		secondAuthor,
 	}
-	mockRepo.On("FindAll").Return(expectedAuthors, nil)
+	mockRepo.On("FindAll", mock.Anything).Return(&port.Page[model.Author]{Items: expectedAuthors, Total: 2}, nil)
 
 	req, _ := http.NewRequest("GET", "/authors", nil)
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
 
-	ctrl.GetAll(c)
+	serve(c, ctrl.GetAll)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -149,14 +148,14 @@ func TestGivenErrorWhenGetAuthorsInControllerThenReturnError(t *testing.T) {
 	app := application.NewAuthorUseCase(svc)
 	ctrl := controller.NewAuthorController(app)
 
-	mockRepo.On("FindAll").Return([]model.Author{}, errors.New("error fetching authors"))
+	mockRepo.On("FindAll", mock.Anything).Return((*port.Page[model.Author])(nil), errors.New("error fetching authors"))
 
 	req, _ := http.NewRequest("GET", "/authors", nil)
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
 
-	ctrl.GetAll(c)
+	serve(c, ctrl.GetAll)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	mockRepo.AssertExpectations(t)
@@ -178,13 +177,13 @@ func TestGivenValidIDWhenGetAuthorInControllerThenReturnAuthor(t *testing.T) {
 	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
 	c.Request = req
 
-	ctrl.GetById(c)
+	serve(c, ctrl.GetById)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	mockRepo.AssertExpectations(t)
 }
 
-func TestGivenInvalidIDWhenGetAuthorInControllerThenReturnError(t *testing.T) {
+func TestGivenUnknownIDWhenGetAuthorInControllerThenReturnNotFound(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	id := uuid.New()
 	authorID := id.String()
@@ -193,7 +192,7 @@ func TestGivenInvalidIDWhenGetAuthorInControllerThenReturnError(t *testing.T) {
 	app := application.NewAuthorUseCase(svc)
 	ctrl := controller.NewAuthorController(app)
 
-	mockRepo.On("FindById", id).Return((*model.Author)(nil), errors.New("not found"))
+	mockRepo.On("FindById", id).Return((*model.Author)(nil), model.ErrAuthorNotFound)
 
 	req, _ := http.NewRequest("GET", "/authors/"+authorID, nil)
 	w := httptest.NewRecorder()
@@ -201,9 +200,9 @@ func TestGivenInvalidIDWhenGetAuthorInControllerThenReturnError(t *testing.T) {
 	c.Params = []gin.Param{{Key: "id", Value: authorID}}
 	c.Request = req
 
-	ctrl.GetById(c)
+	serve(c, ctrl.GetById)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -219,12 +218,12 @@ func TestGivenInvalidJSONWhenCreateInControllerThenReturnBadRequest(t *testing.T
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
 
-	ctrl.Create(c)
+	serve(c, ctrl.Create)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
-func TestGivenInvalidUUIDWhenGetAuthorInControllerThenReturnInternalServerError(t *testing.T) {
+func TestGivenInvalidUUIDWhenGetAuthorInControllerThenReturnBadRequest(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
 	svc := service.NewAuthorService(mockRepo)
@@ -236,9 +235,10 @@ func TestGivenInvalidUUIDWhenGetAuthorInControllerThenReturnInternalServerError(
 	c.Params = []gin.Param{{Key: "id", Value: "invalid-uuid"}}
 	c.Request = req
 
-	ctrl.GetById(c)
+	serve(c, ctrl.GetById)
 
-	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_id"`)
 }
 
 func TestGivenInvalidUUIDWhenUpdateInControllerThenReturnBadRequest(t *testing.T) {
@@ -254,7 +254,7 @@ func TestGivenInvalidUUIDWhenUpdateInControllerThenReturnBadRequest(t *testing.T
 	c.Params = []gin.Param{{Key: "id", Value: "invalid-uuid"}}
 	c.Request = req
 
-	ctrl.Update(c)
+	serve(c, ctrl.Update)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
@@ -273,7 +273,298 @@ func TestGivenInvalidJSONWhenUpdateInControllerThenReturnBadRequest(t *testing.T
 	c.Params = []gin.Param{{Key: "id", Value: validID}}
 	c.Request = req
 
-	ctrl.Update(c)
+	serve(c, ctrl.Update)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestGivenUnlinkedAuthorWhenDeleteInControllerThenReturnNoContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	mockRepo.On("Delete", id, false).Return(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/authors/"+id.String(), nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenLinkedAuthorWhenDeleteInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	mockRepo.On("Delete", id, false).Return(model.ErrAuthorHasBooks)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/authors/"+id.String(), nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrAuthorHasBooks.Error())
+}
+
+func TestGivenForceFlagWhenDeleteInControllerThenForceDelete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	mockRepo.On("Delete", id, true).Return(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/authors/"+id.String()+"?force=true", nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenInvalidForceFlagWhenDeleteInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/authors/"+id.String()+"?force=maybe", nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestGivenDeletedAuthorWhenRestoreInControllerThenReturnAuthor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	author := builder.NewAuthorBuilder().Build()
+	mockRepo.On("Restore", author.ID).Return(author, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/authors/"+author.ID.String()+"/restore", nil)
+	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
+
+	serve(c, ctrl.Restore)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"`+author.ID.String()+`"`)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenIncludeDeletedWhenGetAuthorsInControllerThenReturnList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	authors := []model.Author{*builder.NewAuthorBuilder().Build()}
+	mockRepo.On("FindAll", mock.Anything).Return(&port.Page[model.Author]{Items: authors, Total: 1}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/authors?include_deleted=true", nil)
+	c.Request.Header.Set("Authorization", "Bearer s3cret")
+
+	controller.AdminAuth("s3cret")(c)
+	serve(c, ctrl.GetAll)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenIncludeDeletedWithoutAdminTokenWhenGetAuthorsInControllerThenReturnForbidden(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for name, header := range map[string]string{"anonymous": "", "wrong token": "Bearer guess"} {
+		mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+		ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/authors?include_deleted=true", nil)
+		c.Request.Header.Set("Authorization", header)
+
+		controller.AdminAuth("s3cret")(c)
+		serve(c, ctrl.GetAll)
+
+		assert.Equal(t, http.StatusForbidden, w.Code, name)
+		assert.Contains(t, w.Body.String(), `"code":"include_deleted_forbidden"`, name)
+		mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+	}
+}
+
+func TestGivenNoAdminTokenConfiguredWhenGetAuthorsWithIncludeDeletedThenReturnForbidden(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/authors?include_deleted=true", nil)
+	c.Request.Header.Set("Authorization", "Bearer ")
+
+	controller.AdminAuth("")(c)
+	serve(c, ctrl.GetAll)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestGivenUnknownAuthorWhenUpdateInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	mockRepo.On("Update", id, mock.AnythingOfType("*model.Author")).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PUT", "/authors/"+id.String(), bytes.NewBufferString(`{"first_name":"Ada","last_name":"Lovelace"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Update)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenNullBioWhenPatchInControllerThenClearBio(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	author := builder.NewAuthorBuilder().WithBio("").Build()
+	mockRepo.On("Patch", author.ID, mock.MatchedBy(func(p *model.AuthorPatch) bool {
+		return p.Bio != nil && *p.Bio == "" && p.FirstName == nil && p.LastName == nil
+	})).Return(author, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+author.ID.String(), bytes.NewBufferString(`{"bio":null}`))
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
+
+	serve(c, ctrl.Patch)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"bio":""`)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenNullNameWhenPatchInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+id.String(), bytes.NewBufferString(`{"first_name":null}`))
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Patch)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `first_name`)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
+
+func TestGivenUnknownFieldWhenPatchInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+id.String(), bytes.NewBufferString(`{"id":"x"}`))
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Patch)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestGivenFormBodyWhenPatchInControllerThenReturnUnsupportedMediaType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+id.String(), bytes.NewBufferString(`bio=x`))
+	c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Patch)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestGivenUnknownAuthorWhenPatchInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	mockRepo.On("Patch", id, mock.AnythingOfType("*model.AuthorPatch")).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+id.String(), bytes.NewBufferString(`{"bio":"new"}`))
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Patch)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenInvalidFieldsWhenCreateInControllerThenListEveryField(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	body := `{"first_name":"  ","bio":"` + strings.Repeat("a", 501) + `"}`
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/authors", bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	var problem controller.Problem
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "invalid_fields", problem.Code)
+	assert.Equal(t, []controller.FieldError{
+		{Field: "first_name", Code: "notblank", Message: "must not be blank"},
+		{Field: "last_name", Code: "required", Message: "is required"},
+		{Field: "bio", Code: "max", Message: "must be at most 500 characters"},
+	}, problem.Errors)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenTooLongNameAndUnknownFieldWhenPatchInControllerThenListBoth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := new(mockAuthorRepo.AuthorRepoMock)
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(mockRepo)))
+	id := uuid.New()
+	body := `{"last_name":"` + strings.Repeat("a", 201) + `","id":"x"}`
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PATCH", "/authors/"+id.String(), bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Patch)
+
+	var problem controller.Problem
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, []controller.FieldError{
+		{Field: "id", Code: "unknown_field", Message: "cannot be patched"},
+		{Field: "last_name", Code: "max", Message: "must be at most 200 characters"},
+	}, problem.Errors)
+	mockRepo.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+// linkAuthorRequest is the body of POST /books/:id/authors. An empty role
+// means author and a zero position appends the author to the byline.
+type linkAuthorRequest struct {
+	AuthorID uuid.UUID `json:"author_id" binding:"required"`
+	Role     string    `json:"role" binding:"omitempty,oneof=author editor translator illustrator"`
+	Position int       `json:"position" binding:"gte=0"`
+}
+
+type BookAuthorController struct {
+	app application.BookAuthorUseCaseInterface
+}
+
+func NewBookAuthorController(app application.BookAuthorUseCaseInterface) *BookAuthorController {
+	return &BookAuthorController{app}
+}
+
+func (c *BookAuthorController) Link(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req linkAuthorRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert book author", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	link := &model.BookAuthor{BookID: bookID, AuthorID: req.AuthorID, Role: req.Role, Position: req.Position}
+	if err := c.app.LinkAuthorUseCase(ctx.Request.Context(), link); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to link author to book", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, link)
+}
+
+func (c *BookAuthorController) Unlink(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+	authorID, err := uuid.Parse(ctx.Param("authorId"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	if err := c.app.UnlinkAuthorUseCase(ctx.Request.Context(), bookID, authorID); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to unlink author from book", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *BookAuthorController) GetBookAuthors(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	links, err := c.app.GetBookAuthorsUseCase(ctx.Request.Context(), bookID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get book authors", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, links)
+}
+
+func (c *BookAuthorController) GetAuthorBooks(ctx *gin.Context) {
+	authorID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	links, err := c.app.GetAuthorBooksUseCase(ctx.Request.Context(), authorID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get author books", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, links)
+}
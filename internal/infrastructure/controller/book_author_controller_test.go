@@ -0,0 +1,166 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type bookAuthorControllerMocks struct {
+	links   *mockRepo.BookAuthorRepoMock
+	books   *mockRepo.BookRepoMock
+	authors *mockRepo.AuthorRepoMock
+}
+
+func newBookAuthorController() (*controller.BookAuthorController, bookAuthorControllerMocks) {
+	m := bookAuthorControllerMocks{
+		links:   new(mockRepo.BookAuthorRepoMock),
+		books:   new(mockRepo.BookRepoMock),
+		authors: new(mockRepo.AuthorRepoMock),
+	}
+	app := application.NewBookAuthorUseCase(service.NewBookAuthorService(m.links, m.books, m.authors))
+	return controller.NewBookAuthorController(app), m
+}
+
+func TestGivenAuthorWhenLinkInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newBookAuthorController()
+	book := builder.NewBookBuilder().Build()
+	author := builder.NewAuthorBuilder().Build()
+	m.authors.On("FindById", author.ID).Return(author, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.links.On("FindByBook", book.ID).Return([]model.BookAuthor{}, nil)
+	m.links.On("Link", mock.AnythingOfType("*model.BookAuthor")).Return(nil)
+	body := `{"author_id":"` + author.ID.String() + `","role":"translator"}`
+	req, _ := http.NewRequest("POST", "/books/"+book.ID.String()+"/authors", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Link)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"role":"translator"`)
+	m.links.AssertExpectations(t)
+}
+
+func TestGivenAlreadyLinkedAuthorWhenLinkInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newBookAuthorController()
+	book := builder.NewBookBuilder().Build()
+	author := builder.NewAuthorBuilder().Build()
+	m.authors.On("FindById", author.ID).Return(author, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.links.On("FindByBook", book.ID).Return([]model.BookAuthor{}, nil)
+	m.links.On("Link", mock.AnythingOfType("*model.BookAuthor")).Return(model.ErrBookAuthorAlreadyLinked)
+	body := `{"author_id":"` + author.ID.String() + `"}`
+	req, _ := http.NewRequest("POST", "/books/"+book.ID.String()+"/authors", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Link)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenInvalidRoleWhenLinkInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newBookAuthorController()
+	bookID := uuid.New().String()
+	body := `{"author_id":"` + uuid.New().String() + `","role":"narrator"}`
+	req, _ := http.NewRequest("POST", "/books/"+bookID+"/authors", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: bookID}}
+	c.Request = req
+
+	serve(c, ctrl.Link)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"role"`)
+}
+
+func TestGivenLinkedAuthorWhenUnlinkInControllerThenReturnNoContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newBookAuthorController()
+	bookID, authorID := uuid.New(), uuid.New()
+	m.links.On("Unlink", bookID, authorID).Return(nil)
+	req, _ := http.NewRequest("DELETE", "/books/"+bookID.String()+"/authors/"+authorID.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: bookID.String()}, {Key: "authorId", Value: authorID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Unlink)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	m.links.AssertExpectations(t)
+}
+
+func TestGivenInvalidAuthorIDWhenUnlinkInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newBookAuthorController()
+	bookID := uuid.New().String()
+	req, _ := http.NewRequest("DELETE", "/books/"+bookID+"/authors/invalid", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: bookID}, {Key: "authorId", Value: "invalid"}}
+	c.Request = req
+
+	serve(c, ctrl.Unlink)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenUnknownBookWhenGetBookAuthorsInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newBookAuthorController()
+	id := uuid.New()
+	m.books.On("FindById", id).Return((*model.Book)(nil), model.ErrBookNotFound)
+	req, _ := http.NewRequest("GET", "/books/"+id.String()+"/authors", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetBookAuthors)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenAuthorWhenGetAuthorBooksInControllerThenReturnList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newBookAuthorController()
+	author := builder.NewAuthorBuilder().Build()
+	m.authors.On("FindById", author.ID).Return(author, nil)
+	m.links.On("FindByAuthor", author.ID).Return([]model.BookAuthor{*builder.NewBookAuthorBuilder().Build()}, nil)
+	req, _ := http.NewRequest("GET", "/authors/"+author.ID.String()+"/books", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: author.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetAuthorBooks)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	m.links.AssertExpectations(t)
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+type bookRequest struct {
+	Title         string `json:"title" binding:"required,notblank"`
+	ISBN          string `json:"isbn" binding:"omitempty,isbn"`
+	Description   string `json:"description"`
+	PublishedYear int    `json:"published_year" binding:"omitempty,gt=0"`
+}
+
+// bookUpdateRequest is the body of PUT /books/:id. Fields left empty keep
+// their current value.
+type bookUpdateRequest struct {
+	Title         string `json:"title" binding:"omitempty,notblank"`
+	ISBN          string `json:"isbn" binding:"omitempty,isbn"`
+	Description   string `json:"description"`
+	PublishedYear int    `json:"published_year" binding:"omitempty,gt=0"`
+}
+
+type BookController struct {
+	app application.BookUseCaseInterface
+}
+
+func NewBookController(app application.BookUseCaseInterface) *BookController {
+	return &BookController{app}
+}
+
+func (c *BookController) Create(ctx *gin.Context) {
+	var req bookRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert book", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	book := &model.Book{Title: req.Title, ISBN: req.ISBN, Description: req.Description, PublishedYear: req.PublishedYear}
+	if err := c.app.CreateBookUseCase(ctx.Request.Context(), book); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to create book", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, book)
+}
+
+func (c *BookController) GetAll(ctx *gin.Context) {
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := listQuery(ctx, "title", "isbn", "published_year")
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get list parameters", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	page, err := c.app.GetBooksUseCase(reqCtx, query)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find books", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, newListResponse(ctx, query, page))
+}
+
+func (c *BookController) GetById(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	book, err := c.app.GetBookUseCase(reqCtx, id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get book", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, book)
+}
+
+func (c *BookController) Update(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req bookUpdateRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert book", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	patch := &model.Book{Title: req.Title, ISBN: req.ISBN, Description: req.Description, PublishedYear: req.PublishedYear}
+	updated, err := c.app.UpdateBookUseCase(ctx.Request.Context(), id, patch)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to update book", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, updated)
+}
+
+func (c *BookController) Delete(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	if err := c.app.DeleteBookUseCase(ctx.Request.Context(), id); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to delete book", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *BookController) Restore(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	book, err := c.app.RestoreBookUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to restore book", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, book)
+}
@@ -0,0 +1,279 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockBookRepo "library/internal/test/mock"
+)
+
+func newBookController() (*controller.BookController, *mockBookRepo.BookRepoMock) {
+	mockRepo := new(mockBookRepo.BookRepoMock)
+	app := application.NewBookUseCase(service.NewBookService(mockRepo))
+	return controller.NewBookController(app), mockRepo
+}
+
+func TestGivenABookWhenCreateInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Save", mock.Anything).Return(nil)
+	body, _ := json.Marshal(book)
+	req, _ := http.NewRequest("POST", "/books", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenDuplicatedISBNWhenCreateInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Save", mock.Anything).Return(model.ErrBookISBNConflict)
+	body, _ := json.Marshal(book)
+	req, _ := http.NewRequest("POST", "/books", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenInvalidJSONWhenCreateBookInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newBookController()
+	req, _ := http.NewRequest("POST", "/books", bytes.NewBufferString("{invalid"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenErrorWhenGetBooksInControllerThenReturnError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	mockRepo.On("FindAll", mock.Anything).Return((*port.Page[model.Book])(nil), errors.New("error fetching books"))
+	req, _ := http.NewRequest("GET", "/books", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.GetAll)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenUnknownIDWhenGetBookInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	id := uuid.New()
+	mockRepo.On("FindById", id).Return((*model.Book)(nil), model.ErrBookNotFound)
+	req, _ := http.NewRequest("GET", "/books/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetById)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenInvalidUUIDWhenGetBookInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newBookController()
+	req, _ := http.NewRequest("GET", "/books/invalid-uuid", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: "invalid-uuid"}}
+	c.Request = req
+
+	serve(c, ctrl.GetById)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenBookWhenUpdateInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Update", book.ID, mock.AnythingOfType("*model.Book")).Return(book, nil)
+	body, _ := json.Marshal(book)
+	req, _ := http.NewRequest("PUT", "/books/"+book.ID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Update)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenDuplicatedISBNWhenUpdateInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Update", book.ID, mock.AnythingOfType("*model.Book")).Return((*model.Book)(nil), model.ErrBookISBNConflict)
+	body, _ := json.Marshal(book)
+	req, _ := http.NewRequest("PUT", "/books/"+book.ID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Update)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBookWhenDeleteInControllerThenReturnNoContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	id := uuid.New()
+	mockRepo.On("Delete", id).Return(nil)
+	req, _ := http.NewRequest("DELETE", "/books/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenUnknownBookWhenDeleteInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	id := uuid.New()
+	mockRepo.On("Delete", id).Return(model.ErrBookNotFound)
+	req, _ := http.NewRequest("DELETE", "/books/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenBookInCirculationWhenDeleteInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	id := uuid.New()
+	mockRepo.On("Delete", id).Return(model.ErrBookInCirculation)
+	req, _ := http.NewRequest("DELETE", "/books/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenDeletedBookWhenRestoreInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	book := builder.NewBookBuilder().Build()
+	mockRepo.On("Restore", book.ID).Return(book, nil)
+	req, _ := http.NewRequest("POST", "/books/"+book.ID.String()+"/restore", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Restore)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGivenHyphenatedISBNWhenCreateInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	mockRepo.On("Save", mock.Anything).Return(nil)
+	req, _ := http.NewRequest("POST", "/books",
+		bytes.NewBufferString(`{"title":"Numerical Methods","isbn":"978-0-306-40615-7"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestGivenBadISBNChecksumWhenCreateInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, mockRepo := newBookController()
+	req, _ := http.NewRequest("POST", "/books",
+		bytes.NewBufferString(`{"title":"Numerical Methods","isbn":"0-306-40615-3"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `{"field":"isbn","code":"isbn","message":"must be a valid ISBN-10 or ISBN-13"}`)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGivenWrongTypeWhenCreateBookInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newBookController()
+	req, _ := http.NewRequest("POST", "/books",
+		bytes.NewBufferString(`{"title":"Numerical Methods","published_year":"1999"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"published_year","code":"invalid_type"`)
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+type registerCopyRequest struct {
+	Barcode   string `json:"barcode" binding:"required,notblank"`
+	Condition string `json:"condition"`
+}
+
+type BookCopyController struct {
+	app application.BookCopyUseCaseInterface
+}
+
+func NewBookCopyController(app application.BookCopyUseCaseInterface) *BookCopyController {
+	return &BookCopyController{app}
+}
+
+func (c *BookCopyController) Register(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req registerCopyRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	bookCopy := &model.BookCopy{BookID: bookID, Barcode: req.Barcode, Condition: req.Condition}
+	if err := c.app.RegisterCopyUseCase(ctx.Request.Context(), bookCopy); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to register book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, bookCopy)
+}
+
+func (c *BookCopyController) GetBookCopies(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	copies, err := c.app.GetBookCopiesUseCase(reqCtx, bookID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find book copies", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, copies)
+}
+
+func (c *BookCopyController) GetById(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	bookCopy, err := c.app.GetCopyUseCase(reqCtx, id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, bookCopy)
+}
+
+func (c *BookCopyController) GetByBarcode(ctx *gin.Context) {
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	bookCopy, err := c.app.GetCopyByBarcodeUseCase(reqCtx, ctx.Param("barcode"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get book copy by barcode", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, bookCopy)
+}
+
+func (c *BookCopyController) Retire(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	bookCopy, err := c.app.RetireCopyUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to retire book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, bookCopy)
+}
+
+func (c *BookCopyController) Reactivate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	bookCopy, err := c.app.ReactivateCopyUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to reactivate book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, bookCopy)
+}
+
+func (c *BookCopyController) Delete(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	if err := c.app.DeleteCopyUseCase(ctx.Request.Context(), id); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to delete book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *BookCopyController) Restore(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	bookCopy, err := c.app.RestoreCopyUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to restore book copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, bookCopy)
+}
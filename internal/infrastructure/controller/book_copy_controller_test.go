@@ -0,0 +1,234 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func newBookCopyController() (*controller.BookCopyController, *mockRepo.BookCopyRepoMock, *mockRepo.BookRepoMock) {
+	copies, books := new(mockRepo.BookCopyRepoMock), new(mockRepo.BookRepoMock)
+	app := application.NewBookCopyUseCase(service.NewBookCopyService(mockRepo.TransactionMock{}, copies, books, nil))
+	return controller.NewBookCopyController(app), copies, books
+}
+
+func TestGivenCopyWhenRegisterInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, books := newBookCopyController()
+	book := builder.NewBookBuilder().Build()
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("Save", mock.AnythingOfType("*model.BookCopy")).Return(nil)
+	req, _ := http.NewRequest("POST", "/books/"+book.ID.String()+"/copies",
+		bytes.NewBufferString(`{"barcode":"LIB-0001","condition":"GOOD"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Register)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"book_id":"`+book.ID.String()+`"`)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenDuplicatedBarcodeWhenRegisterInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, books := newBookCopyController()
+	book := builder.NewBookBuilder().Build()
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("Save", mock.AnythingOfType("*model.BookCopy")).Return(model.ErrCopyBarcodeConflict)
+	req, _ := http.NewRequest("POST", "/books/"+book.ID.String()+"/copies", bytes.NewBufferString(`{"barcode":"LIB-0001"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Register)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenInvalidBookIDWhenGetBookCopiesInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _, _ := newBookCopyController()
+	req, _ := http.NewRequest("GET", "/books/invalid/copies", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: "invalid"}}
+	c.Request = req
+
+	serve(c, ctrl.GetBookCopies)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenBookWhenGetBookCopiesInControllerThenReturnAvailability(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, books := newBookCopyController()
+	book := builder.NewBookBuilder().Build()
+	onLoan := builder.NewBookCopyBuilder().WithBookID(book.ID).WithAvailable(false).Build()
+	books.On("FindById", book.ID).Return(book, nil)
+	copies.On("FindByBook", book.ID).Return([]model.BookCopy{*onLoan}, nil)
+	req, _ := http.NewRequest("GET", "/books/"+book.ID.String()+"/copies", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetBookCopies)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"available":false`)
+}
+
+func TestGivenUnknownCopyWhenGetByIdInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	id := uuid.New()
+	copies.On("FindById", id).Return((*model.BookCopy)(nil), model.ErrCopyNotFound)
+	req, _ := http.NewRequest("GET", "/copies/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetById)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenBarcodeWhenGetByBarcodeInControllerThenReturnCopy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	bookCopy := builder.NewBookCopyBuilder().WithBarcode("LIB-0042").Build()
+	copies.On("FindByBarcode", "LIB-0042").Return(bookCopy, nil)
+	req, _ := http.NewRequest("GET", "/copies/barcode/LIB-0042", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "barcode", Value: "LIB-0042"}}
+	c.Request = req
+
+	serve(c, ctrl.GetByBarcode)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"barcode":"LIB-0042"`)
+}
+
+func TestGivenCopyOnLoanWhenRetireInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	copies, reservations := new(mockRepo.BookCopyRepoMock), new(mockRepo.ReservationRepoMock)
+	ctrl := controller.NewBookCopyController(application.NewBookCopyUseCase(
+		service.NewBookCopyService(mockRepo.TransactionMock{}, copies, nil, reservations)))
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	reservations.On("FindReadyByCopy", bookCopy.ID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+	req, _ := http.NewRequest("POST", "/copies/"+bookCopy.ID.String()+"/retire", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: bookCopy.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Retire)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"copy_already_on_loan"`)
+}
+
+func TestGivenRetiredCopyWhenReactivateInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	copies.On("SetActive", bookCopy.ID, true).Return(bookCopy, nil)
+	req, _ := http.NewRequest("POST", "/copies/"+bookCopy.ID.String()+"/reactivate", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: bookCopy.ID.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Reactivate)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyOnLoanWhenDeleteInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	id := uuid.New()
+	copies.On("Delete", id).Return(model.ErrCopyInCirculation)
+	req, _ := http.NewRequest("DELETE", "/copies/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenCopyOnShelfWhenDeleteInControllerThenReturnNoContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	id := uuid.New()
+	copies.On("Delete", id).Return(nil)
+	req, _ := http.NewRequest("DELETE", "/copies/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	copies.AssertExpectations(t)
+}
+
+func TestGivenCopyOfDeletedBookWhenRestoreInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	id := uuid.New()
+	copies.On("Restore", id).Return((*model.BookCopy)(nil), model.ErrBookNotFound)
+	req, _ := http.NewRequest("POST", "/copies/"+id.String()+"/restore", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.Restore)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenInvalidIncludeDeletedWhenGetByIdInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, copies, _ := newBookCopyController()
+	id := uuid.New()
+	req, _ := http.NewRequest("GET", "/copies/"+id.String()+"?include_deleted=maybe", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+	c.Request = req
+
+	serve(c, ctrl.GetById)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid include_deleted flag")
+	copies.AssertNotCalled(t, "FindById", mock.Anything)
+}
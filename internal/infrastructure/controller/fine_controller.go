@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+type FineController struct {
+	app application.FineUseCaseInterface
+}
+
+type fineTransactionRequest struct {
+	Kind        string `json:"kind" binding:"required,oneof=payment waiver"`
+	AmountCents int    `json:"amount_cents" binding:"gt=0"`
+	Note        string `json:"note"`
+}
+
+func NewFineController(app application.FineUseCaseInterface) *FineController {
+	return &FineController{app}
+}
+
+func (c *FineController) GetMemberFines(ctx *gin.Context) {
+	memberID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	summary, err := c.app.GetMemberFinesUseCase(ctx.Request.Context(), memberID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get member fines", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, summary)
+}
+
+func (c *FineController) GetLoanFine(ctx *gin.Context) {
+	loanID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	fine, err := c.app.GetLoanFineUseCase(ctx.Request.Context(), loanID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get loan fine", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, fine)
+}
+
+func (c *FineController) RecordTransaction(ctx *gin.Context) {
+	loanID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req fineTransactionRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert fine transaction", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	transaction, err := c.app.RecordFineTransactionUseCase(ctx.Request.Context(), loanID, &model.FineTransaction{
+		Kind:        req.Kind,
+		AmountCents: req.AmountCents,
+		Note:        req.Note,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to record fine transaction", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, transaction)
+}
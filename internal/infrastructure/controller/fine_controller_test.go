@@ -0,0 +1,145 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type fineControllerMocks struct {
+	loans        *mockRepo.LoanRepoMock
+	members      *mockRepo.MemberRepoMock
+	transactions *mockRepo.FineTransactionRepoMock
+}
+
+func newFineController() (*controller.FineController, fineControllerMocks) {
+	m := fineControllerMocks{
+		loans:        new(mockRepo.LoanRepoMock),
+		members:      new(mockRepo.MemberRepoMock),
+		transactions: new(mockRepo.FineTransactionRepoMock),
+	}
+	policy := service.CategoryFinePolicy{}
+	svc := service.NewFineService(mockRepo.TransactionMock{}, m.loans, m.members, m.transactions, policy)
+	return controller.NewFineController(application.NewFineUseCase(svc)), m
+}
+
+func TestGivenMemberWhenGetMemberFinesInControllerThenReturnStatusOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newFineController()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(75).Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("FindByMember", member.ID).Return([]model.Loan{*loan}, nil)
+	m.transactions.On("FindByMember", member.ID).Return([]model.FineTransaction{}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/members/"+member.ID.String()+"/fines", nil)
+	c.Params = []gin.Param{{Key: "id", Value: member.ID.String()}}
+
+	serve(c, ctrl.GetMemberFines)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"outstanding_cents":75`)
+}
+
+func TestGivenInvalidIDWhenGetMemberFinesInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newFineController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/members/invalid/fines", nil)
+	c.Params = []gin.Param{{Key: "id", Value: "invalid"}}
+
+	serve(c, ctrl.GetMemberFines)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenUnknownLoanWhenGetLoanFineInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newFineController()
+	id := uuid.New()
+	m.loans.On("FindById", id).Return((*model.Loan)(nil), model.ErrLoanNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/loans/"+id.String()+"/fine", nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.GetLoanFine)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenPaymentWhenRecordTransactionInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newFineController()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(75).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+	m.transactions.On("Save", mock.AnythingOfType("*model.FineTransaction")).Return(nil)
+	body := `{"kind":"payment","amount_cents":50,"note":"cash"}`
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/"+loan.ID.String()+"/fine/transactions", bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: loan.ID.String()}}
+
+	serve(c, ctrl.RecordTransaction)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	m.transactions.AssertExpectations(t)
+}
+
+func TestGivenOverpaymentWhenRecordTransactionInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newFineController()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).WithReturnedAt(time.Now()).WithFineCents(75).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.transactions.On("FindByLoan", loan.ID).Return([]model.FineTransaction{}, nil)
+	body := `{"kind":"payment","amount_cents":500}`
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/"+loan.ID.String()+"/fine/transactions", bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: loan.ID.String()}}
+
+	serve(c, ctrl.RecordTransaction)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrFineAmountExceedsBalance.Error())
+}
+
+func TestGivenInvalidKindWhenRecordTransactionInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newFineController()
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/"+id.String()+"/fine/transactions",
+		bytes.NewBufferString(`{"kind":"refund","amount_cents":10}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.RecordTransaction)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"kind"`)
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"library/internal/infrastructure/health"
+)
+
+type HealthController struct {
+	readiness *health.Readiness
+}
+
+func NewHealthController(readiness *health.Readiness) *HealthController {
+	return &HealthController{readiness}
+}
+
+// Live serves GET /health/live. It answers as long as the process serves
+// requests and checks no dependency, so a database outage does not get the
+// service restarted.
+func (c *HealthController) Live(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": health.StatusUp})
+}
+
+// Ready serves GET /health/ready with the status of every dependency, and 503
+// while any of them is down so that no traffic is routed to this instance.
+func (c *HealthController) Ready(ctx *gin.Context) {
+	report := c.readiness.Check(ctx.Request.Context())
+	if report.Status != health.StatusUp {
+		slog.WarnContext(ctx.Request.Context(), "Service not ready", "components", report.Components)
+		ctx.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+	ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,51 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/infrastructure/controller"
+	"library/internal/infrastructure/health"
+)
+
+func newHealthController(database error) *controller.HealthController {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Register("database", health.CheckerFunc(func(context.Context) error { return database }))
+	return controller.NewHealthController(readiness)
+}
+
+func TestGivenDatabaseUpWhenReadyInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := newHealthController(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/health/ready", nil)
+
+	serve(c, ctrl.Ready)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"up"`)
+	assert.Contains(t, w.Body.String(), `"database":{"status":"up"`)
+}
+
+func TestGivenDatabaseDownWhenReadyInControllerThenReturnServiceUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := newHealthController(errors.New("connection refused"))
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/health/ready", nil)
+
+	serve(c, ctrl.Ready)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"down"`)
+	assert.Contains(t, w.Body.String(), `"error":"unavailable"`)
+	assert.NotContains(t, w.Body.String(), "connection refused")
+}
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"library/internal/domain/domainerror"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+var (
+	errInvalidLimit      = domainerror.NewValidation("invalid_limit", "limit and size must be between 1 and 100")
+	errInvalidPage       = domainerror.NewValidation("invalid_page", "page must be a positive number")
+	errInvalidPagination = domainerror.NewValidation("invalid_pagination", "use either cursor and limit or page and size")
+)
+
+// listResponse is the body of a list endpoint. Links are relative to the API
+// host; Next and Prev are left out on the last and first page.
+type listResponse[T any] struct {
+	Data  []T       `json:"data"`
+	Total int64     `json:"total"`
+	Links listLinks `json:"links"`
+}
+
+type listLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// cursorToken is the opaque cursor handed to clients. It remembers the sort
+// it was issued for, so it cannot be replayed against another order.
+type cursorToken struct {
+	Sort   string `json:"s"`
+	Value  string `json:"v"`
+	Key    string `json:"k"`
+	Before bool   `json:"b,omitempty"`
+}
+
+// listQuery reads the paging, sort and filter parameters of a list request.
+// Lists are read by keyset with limit and cursor, or by offset with page and
+// size. sort names a field, prefixed with - for descending order; filters
+// names the query parameters the endpoint accepts as filters.
+func listQuery(ctx *gin.Context, filters ...string) (port.ListQuery, error) {
+	var query port.ListQuery
+	_, paged := ctx.GetQuery("page")
+	_, sized := ctx.GetQuery("size")
+	_, limited := ctx.GetQuery("limit")
+	cursorParam, hasCursor := ctx.GetQuery("cursor")
+	if (paged || sized) && (limited || hasCursor) {
+		return query, errInvalidPagination
+	}
+
+	sizeParam := "limit"
+	if paged || sized {
+		sizeParam = "size"
+		page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			return query, errInvalidPage
+		}
+		query.Page = page
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery(sizeParam, strconv.Itoa(port.DefaultListLimit)))
+	if err != nil || limit < 1 || limit > port.MaxListLimit {
+		return query, errInvalidLimit
+	}
+	query.Limit = limit
+
+	sort := ctx.Query("sort")
+	if hasCursor {
+		token, err := decodeCursor(cursorParam)
+		if err != nil || (sort != "" && sort != token.Sort) {
+			return query, model.ErrInvalidCursor
+		}
+		sort = token.Sort
+		query.Cursor = &port.Cursor{Value: token.Value, Key: token.Key, Before: token.Before}
+	}
+	query.Sort, query.Desc = strings.CutPrefix(sort, "-")
+
+	for _, name := range filters {
+		if value, ok := ctx.GetQuery(name); ok {
+			if query.Filters == nil {
+				query.Filters = map[string]string{}
+			}
+			query.Filters[name] = value
+		}
+	}
+	return query, nil
+}
+
+// newListResponse wraps page with its total and the links to the pages next to
+// it. The links keep the filters and sort of the request.
+func newListResponse[T any](ctx *gin.Context, query port.ListQuery, page *port.Page[T]) listResponse[T] {
+	items := page.Items
+	if items == nil {
+		items = []T{}
+	}
+	response := listResponse[T]{Data: items, Total: page.Total, Links: listLinks{Self: ctx.Request.URL.RequestURI()}}
+
+	sort := query.Sort
+	if query.Desc {
+		sort = "-" + sort
+	}
+	link := func(cursor *port.Cursor, page int) string {
+		values := ctx.Request.URL.Query()
+		if query.Page > 0 {
+			values.Set("page", strconv.Itoa(page))
+		} else {
+			values.Set("cursor", encodeCursor(sort, cursor))
+		}
+		return ctx.Request.URL.Path + "?" + values.Encode()
+	}
+	if page.Next != nil {
+		response.Links.Next = link(page.Next, query.Page+1)
+	}
+	if page.Prev != nil {
+		response.Links.Prev = link(page.Prev, query.Page-1)
+	}
+	return response
+}
+
+func encodeCursor(sort string, cursor *port.Cursor) string {
+	raw, _ := json.Marshal(cursorToken{Sort: sort, Value: cursor.Value, Key: cursor.Key, Before: cursor.Before})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(encoded string) (cursorToken, error) {
+	var token cursorToken
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return token, err
+	}
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return token, err
+	}
+	if token.Key == "" {
+		return token, model.ErrInvalidCursor
+	}
+	return token, nil
+}
@@ -0,0 +1,165 @@
+package controller_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockAuthorRepo "library/internal/test/mock"
+)
+
+type authorList struct {
+	Data  []model.Author `json:"data"`
+	Total int64          `json:"total"`
+	Links struct {
+		Self string `json:"self"`
+		Next string `json:"next"`
+		Prev string `json:"prev"`
+	} `json:"links"`
+}
+
+func getAuthors(t *testing.T, repo *mockAuthorRepo.AuthorRepoMock, target string) (*httptest.ResponseRecorder, authorList) {
+	ctrl := controller.NewAuthorController(application.NewAuthorUseCase(service.NewAuthorService(repo)))
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", target, nil)
+
+	serve(c, ctrl.GetAll)
+
+	var body authorList
+	if w.Code == http.StatusOK {
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	}
+	return w, body
+}
+
+func TestGivenSortAndFilterWhenGetAuthorsInControllerThenFollowNextLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := new(mockAuthorRepo.AuthorRepoMock)
+	author := builder.NewAuthorBuilder().Build()
+	next := &port.Cursor{Value: author.LastName, Key: author.ID.String()}
+	repo.On("FindAll", port.ListQuery{
+		Limit:   1,
+		Sort:    "last_name",
+		Desc:    true,
+		Filters: map[string]string{"last_name": "Gar"},
+	}).Return(&port.Page[model.Author]{Items: []model.Author{*author}, Total: 3, Next: next}, nil).Once()
+
+	w, first := getAuthors(t, repo, "/authors?limit=1&sort=-last_name&last_name=Gar")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(3), first.Total)
+	assert.Equal(t, "/authors?limit=1&sort=-last_name&last_name=Gar", first.Links.Self)
+	assert.Empty(t, first.Links.Prev)
+	link, err := url.Parse(first.Links.Next)
+	assert.NoError(t, err)
+	assert.Equal(t, "Gar", link.Query().Get("last_name"))
+
+	repo.On("FindAll", port.ListQuery{
+		Limit:   1,
+		Sort:    "last_name",
+		Desc:    true,
+		Cursor:  next,
+		Filters: map[string]string{"last_name": "Gar"},
+	}).Return(&port.Page[model.Author]{Items: []model.Author{}, Total: 3}, nil).Once()
+
+	w, second := getAuthors(t, repo, first.Links.Next)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, second.Data)
+	repo.AssertExpectations(t)
+}
+
+func TestGivenPageAndSizeWhenGetAuthorsInControllerThenLinkAdjacentPages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := new(mockAuthorRepo.AuthorRepoMock)
+	cursor := &port.Cursor{Value: "v", Key: "k"}
+	repo.On("FindAll", port.ListQuery{Limit: 10, Page: 2}).
+		Return(&port.Page[model.Author]{Total: 35, Next: cursor, Prev: cursor}, nil)
+
+	w, body := getAuthors(t, repo, "/authors?page=2&size=10")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []model.Author{}, body.Data)
+	assert.Equal(t, "/authors?page=3&size=10", body.Links.Next)
+	assert.Equal(t, "/authors?page=1&size=10", body.Links.Prev)
+}
+
+func TestGivenInvalidListParametersWhenGetAuthorsInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for target, code := range map[string]string{
+		"/authors?limit=500":              "invalid_limit",
+		"/authors?page=0":                 "invalid_page",
+		"/authors?page=2&cursor=abc":      "invalid_pagination",
+		"/authors?cursor=not-a-cursor":    "invalid_cursor",
+		"/authors?size=10&limit=10":       "invalid_pagination",
+		"/authors?cursor=eyJzIjoiIn0&x=1": "invalid_cursor",
+	} {
+		repo := new(mockAuthorRepo.AuthorRepoMock)
+
+		w, _ := getAuthors(t, repo, target)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+		assert.Contains(t, w.Body.String(), `"code":"`+code+`"`, target)
+		repo.AssertNotCalled(t, "FindAll", mock.Anything)
+	}
+}
+
+func TestGivenCursorWithMalformedValueWhenGetAuthorsInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := new(mockAuthorRepo.AuthorRepoMock)
+	repo.On("FindAll", mock.Anything).Return((*port.Page[model.Author])(nil), model.ErrInvalidCursor)
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at","v":"yesterday","k":"` + uuid.NewString() + `"}`))
+
+	w, _ := getAuthors(t, repo, "/authors?cursor="+cursor)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_cursor"`)
+	repo.AssertCalled(t, "FindAll", mock.MatchedBy(func(query port.ListQuery) bool {
+		return query.Sort == "created_at" && query.Cursor.Value == "yesterday"
+	}))
+}
+
+func TestGivenCursorForAnotherSortWhenGetAuthorsInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := new(mockAuthorRepo.AuthorRepoMock)
+	author := builder.NewAuthorBuilder().Build()
+	repo.On("FindAll", mock.Anything).Return(&port.Page[model.Author]{
+		Items: []model.Author{*author},
+		Next:  &port.Cursor{Value: "x", Key: author.ID.String()},
+	}, nil).Once()
+	_, first := getAuthors(t, repo, "/authors?sort=last_name")
+	next, _ := url.Parse(first.Links.Next)
+	query := next.Query()
+	query.Set("sort", "first_name")
+
+	w, _ := getAuthors(t, repo, "/authors?"+query.Encode())
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_cursor"`)
+}
+
+func TestGivenUnknownSortWhenGetAuthorsInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := new(mockAuthorRepo.AuthorRepoMock)
+	repo.On("FindAll", mock.Anything).Return((*port.Page[model.Author])(nil), model.ErrInvalidSort)
+
+	w, _ := getAuthors(t, repo, "/authors?sort=bio")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_sort"`)
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/infrastructure/logging"
+)
+
+type LoanController struct {
+	app application.LoanUseCaseInterface
+}
+
+// checkoutRequest is the body of POST /loans/checkout. DueDate overrides the
+// loan period of the member's category.
+type checkoutRequest struct {
+	MemberID uuid.UUID  `json:"member_id" binding:"required"`
+	CopyID   uuid.UUID  `json:"copy_id" binding:"required"`
+	DueDate  *time.Time `json:"due_date" binding:"omitempty,future"`
+}
+
+type returnRequest struct {
+	CopyID uuid.UUID `json:"copy_id" binding:"required"`
+}
+
+func NewLoanController(app application.LoanUseCaseInterface) *LoanController {
+	return &LoanController{app}
+}
+
+func (c *LoanController) Checkout(ctx *gin.Context) {
+	var req checkoutRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert checkout", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	logging.AddAttrs(ctx.Request.Context(), slog.String("member_id", req.MemberID.String()))
+	loan, err := c.app.CheckoutUseCase(ctx.Request.Context(), req.MemberID, req.CopyID, req.DueDate)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to checkout copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, loan)
+}
+
+func (c *LoanController) Return(ctx *gin.Context) {
+	var req returnRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert return", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	loan, err := c.app.ReturnUseCase(ctx.Request.Context(), req.CopyID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to return copy", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, loan)
+}
+
+func (c *LoanController) Renew(ctx *gin.Context) {
+	loanID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	loan, err := c.app.RenewUseCase(ctx.Request.Context(), loanID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to renew loan", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, loan)
+}
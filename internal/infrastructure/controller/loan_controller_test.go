@@ -0,0 +1,248 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type loanControllerMocks struct {
+	loans        *mockRepo.LoanRepoMock
+	copies       *mockRepo.BookCopyRepoMock
+	members      *mockRepo.MemberRepoMock
+	reservations *mockRepo.ReservationRepoMock
+}
+
+func newLoanController() (*controller.LoanController, loanControllerMocks) {
+	m := loanControllerMocks{
+		loans:        new(mockRepo.LoanRepoMock),
+		copies:       new(mockRepo.BookCopyRepoMock),
+		members:      new(mockRepo.MemberRepoMock),
+		reservations: new(mockRepo.ReservationRepoMock),
+	}
+	svc := service.NewLoanService(mockRepo.TransactionMock{}, m.loans, m.copies, m.members, m.reservations,
+		service.CategoryFinePolicy{}, service.DefaultLoanPolicy())
+	return controller.NewLoanController(application.NewLoanUseCase(svc)), m
+}
+
+func TestGivenAvailableCopyWhenCheckoutInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.loans.On("Save", mock.AnythingOfType("*model.Loan")).Return(nil)
+	body := `{"member_id":"` + member.ID.String() + `","copy_id":"` + bookCopy.ID.String() + `"}`
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	m.loans.AssertExpectations(t)
+}
+
+func TestGivenCopyOnLoanWhenCheckoutInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.reservations.On("FindReadyByCopy", bookCopy.ID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+	body := `{"member_id":"` + member.ID.String() + `","copy_id":"` + bookCopy.ID.String() + `"}`
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrCopyAlreadyOnLoan.Error())
+}
+
+func TestGivenCopyHeldForAnotherMemberWhenCheckoutInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	member := builder.NewMemberBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithAvailable(false).Build()
+	hold := builder.NewReservationBuilder().WithBookID(bookCopy.BookID).Build()
+	m.members.On("FindByIdForUpdate", member.ID).Return(member, nil)
+	m.loans.On("CountActiveByMember", member.ID).Return(0, nil)
+	m.copies.On("FindByIdForUpdate", bookCopy.ID).Return(bookCopy, nil)
+	m.reservations.On("FindReadyByCopy", bookCopy.ID).Return(hold, nil)
+	body := `{"member_id":"` + member.ID.String() + `","copy_id":"` + bookCopy.ID.String() + `"}`
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrCopyOnHoldShelf.Error())
+}
+
+func TestGivenUnknownMemberWhenCheckoutInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	memberID := uuid.New()
+	m.members.On("FindByIdForUpdate", memberID).Return((*model.Member)(nil), model.ErrMemberNotFound)
+	body := `{"member_id":"` + memberID.String() + `","copy_id":"` + uuid.NewString() + `"}`
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenMissingCopyIDWhenCheckoutInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newLoanController()
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(`{"member_id":"`+uuid.NewString()+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"copy_id"`)
+}
+
+func TestGivenCopyOnLoanWhenReturnInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	member := builder.NewMemberBuilder().Build()
+	loan := builder.NewLoanBuilder().WithMemberID(member.ID).Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindActiveByCopy", loan.CopyID).Return(loan, nil)
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("Close", loan).Return(nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("FindNextForBook", bookCopy.BookID).Return((*model.Reservation)(nil), model.ErrReservationNotFound)
+	req, _ := http.NewRequest("POST", "/loans/return", bytes.NewBufferString(`{"copy_id":"`+loan.CopyID.String()+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Return)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"returned_at":"`)
+}
+
+func TestGivenCopyWithoutLoanWhenReturnInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	copyID := uuid.New()
+	m.loans.On("FindActiveByCopy", copyID).Return((*model.Loan)(nil), model.ErrCopyNotOnLoan)
+	req, _ := http.NewRequest("POST", "/loans/return", bytes.NewBufferString(`{"copy_id":"`+copyID.String()+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Return)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenRenewableLoanWhenRenewInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	loan := builder.NewLoanBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(false, nil)
+	m.loans.On("Renew", loan).Return(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/"+loan.ID.String()+"/renew", nil)
+	c.Params = []gin.Param{{Key: "id", Value: loan.ID.String()}}
+
+	serve(c, ctrl.Renew)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"renewal_count":1`)
+}
+
+func TestGivenReservedBookWhenRenewInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	loan := builder.NewLoanBuilder().Build()
+	bookCopy := builder.NewBookCopyBuilder().WithID(loan.CopyID).Build()
+	m.loans.On("FindByIdForUpdate", loan.ID).Return(loan, nil)
+	m.members.On("FindById", loan.MemberID).Return(builder.NewMemberBuilder().WithID(loan.MemberID).Build(), nil)
+	m.copies.On("FindById", loan.CopyID).Return(bookCopy, nil)
+	m.reservations.On("ExistsActiveForBook", bookCopy.BookID, loan.MemberID).Return(true, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/"+loan.ID.String()+"/renew", nil)
+	c.Params = []gin.Param{{Key: "id", Value: loan.ID.String()}}
+
+	serve(c, ctrl.Renew)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrBookReservedByAnother.Error())
+}
+
+func TestGivenInvalidIDWhenRenewInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newLoanController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans/invalid/renew", nil)
+	c.Params = []gin.Param{{Key: "id", Value: "invalid"}}
+
+	serve(c, ctrl.Renew)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenPastDueDateWhenCheckoutInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newLoanController()
+	body := `{"member_id":"` + uuid.NewString() + `","copy_id":"` + uuid.NewString() + `","due_date":"2020-01-01T00:00:00Z"}`
+	req, _ := http.NewRequest("POST", "/loans/checkout", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	serve(c, ctrl.Checkout)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `{"field":"due_date","code":"future","message":"must be in the future"}`)
+	m.members.AssertNotCalled(t, "FindByIdForUpdate", mock.Anything)
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+type registerMemberRequest struct {
+	FullName string `json:"full_name" binding:"required,notblank"`
+	Email    string `json:"email" binding:"required,email"`
+	Phone    string `json:"phone"`
+	Category string `json:"category"`
+}
+
+// updateMemberRequest is the body of PUT /members/:id. Fields left empty keep
+// their current value.
+type updateMemberRequest struct {
+	FullName string `json:"full_name" binding:"omitempty,notblank"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	Phone    string `json:"phone"`
+	Category string `json:"category"`
+}
+
+type MemberController struct {
+	app application.MemberUseCaseInterface
+}
+
+func NewMemberController(app application.MemberUseCaseInterface) *MemberController {
+	return &MemberController{app}
+}
+
+func (c *MemberController) Create(ctx *gin.Context) {
+	var req registerMemberRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert member", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	member := &model.Member{FullName: req.FullName, Email: req.Email, Phone: req.Phone, Category: req.Category}
+	if err := c.app.RegisterMemberUseCase(ctx.Request.Context(), member); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to create member", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, member)
+}
+
+func (c *MemberController) GetAll(ctx *gin.Context) {
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := listQuery(ctx, "full_name", "email", "category", "is_active")
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get list parameters", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	page, err := c.app.GetMembersUseCase(reqCtx, query)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find members", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, newListResponse(ctx, query, page))
+}
+
+func (c *MemberController) GetById(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	member, err := c.app.GetMemberUseCase(reqCtx, id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, member)
+}
+
+func (c *MemberController) GetByEmail(ctx *gin.Context) {
+	reqCtx, ok := readContext(ctx)
+	if !ok {
+		return
+	}
+
+	member, err := c.app.GetMemberByEmailUseCase(reqCtx, ctx.Param("email"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get member by email", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, member)
+}
+
+func (c *MemberController) GetDetail(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	detail, err := c.app.GetMemberDetailUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get member detail", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, detail)
+}
+
+func (c *MemberController) Update(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req updateMemberRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert member", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	patch := &model.Member{FullName: req.FullName, Email: req.Email, Phone: req.Phone, Category: req.Category}
+	updated, err := c.app.UpdateMemberUseCase(ctx.Request.Context(), id, patch)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to update member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, updated)
+}
+
+func (c *MemberController) Activate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	member, err := c.app.ActivateMemberUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to activate member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, member)
+}
+
+func (c *MemberController) Deactivate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	member, err := c.app.DeactivateMemberUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to deactivate member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, member)
+}
+
+func (c *MemberController) Delete(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	if err := c.app.DeleteMemberUseCase(ctx.Request.Context(), id); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to delete member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *MemberController) Restore(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	member, err := c.app.RestoreMemberUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to restore member", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, member)
+}
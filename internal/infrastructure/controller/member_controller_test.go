@@ -0,0 +1,221 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type memberControllerMocks struct {
+	members      *mockRepo.MemberRepoMock
+	categories   *mockRepo.MembershipCategoryRepoMock
+	loans        *mockRepo.LoanRepoMock
+	reservations *mockRepo.ReservationRepoMock
+	transactions *mockRepo.FineTransactionRepoMock
+}
+
+func newMemberController() (*controller.MemberController, memberControllerMocks) {
+	m := memberControllerMocks{
+		members:      new(mockRepo.MemberRepoMock),
+		categories:   new(mockRepo.MembershipCategoryRepoMock),
+		loans:        new(mockRepo.LoanRepoMock),
+		reservations: new(mockRepo.ReservationRepoMock),
+		transactions: new(mockRepo.FineTransactionRepoMock),
+	}
+	fines := service.NewFineService(mockRepo.TransactionMock{}, m.loans, m.members, m.transactions,
+		service.CategoryFinePolicy{})
+	svc := service.NewMemberService(m.members, m.categories, m.loans, m.reservations, fines)
+	return controller.NewMemberController(application.NewMemberUseCase(svc)), m
+}
+
+func TestGivenMemberWhenCreateInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	m.categories.On("FindByName", model.DefaultMemberCategory).
+		Return(builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build(), nil)
+	m.members.On("Save", mock.AnythingOfType("*model.Member")).Return(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members",
+		bytes.NewBufferString(`{"full_name":"Ada Lovelace","email":"ada@example.com"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"is_active":true`)
+}
+
+func TestGivenInvalidEmailWhenCreateInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newMemberController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members",
+		bytes.NewBufferString(`{"full_name":"Ada Lovelace","email":"ada"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"email"`)
+}
+
+func TestGivenDuplicateEmailWhenCreateInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	m.categories.On("FindByName", model.DefaultMemberCategory).
+		Return(builder.NewMembershipCategoryBuilder().WithName(model.DefaultMemberCategory).Build(), nil)
+	m.members.On("Save", mock.AnythingOfType("*model.Member")).Return(model.ErrMemberEmailConflict)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members",
+		bytes.NewBufferString(`{"full_name":"Ada Lovelace","email":"ada@example.com"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenUnknownCategoryWhenCreateInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	m.categories.On("FindByName", "vip").
+		Return((*model.MembershipCategory)(nil), model.ErrMembershipCategoryNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members",
+		bytes.NewBufferString(`{"full_name":"Ada Lovelace","email":"ada@example.com","category":"vip"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenEmailWhenGetByEmailInControllerThenReturnMember(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	member := builder.NewMemberBuilder().Build()
+	m.members.On("FindByEmail", member.Email).Return(member, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/members/email/"+member.Email, nil)
+	c.Params = []gin.Param{{Key: "email", Value: member.Email}}
+
+	serve(c, ctrl.GetByEmail)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), member.ID.String())
+}
+
+func TestGivenMemberWhenGetDetailInControllerThenReturnAggregate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	member := builder.NewMemberBuilder().Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.loans.On("FindActiveByMember", member.ID).Return([]model.Loan{}, nil)
+	m.loans.On("FindByMember", member.ID).Return([]model.Loan{}, nil)
+	m.transactions.On("FindByMember", member.ID).Return([]model.FineTransaction{}, nil)
+	m.reservations.On("FindActiveByMember", member.ID).Return([]model.Reservation{}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/members/"+member.ID.String()+"/detail", nil)
+	c.Params = []gin.Param{{Key: "id", Value: member.ID.String()}}
+
+	serve(c, ctrl.GetDetail)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"active_loans":[]`)
+	assert.Contains(t, w.Body.String(), `"outstanding_fine_cents":0`)
+	assert.Contains(t, w.Body.String(), `"active_reservations":[]`)
+}
+
+func TestGivenInvalidIDWhenDeactivateInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newMemberController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members/123/deactivate", nil)
+	c.Params = []gin.Param{{Key: "id", Value: "123"}}
+
+	serve(c, ctrl.Deactivate)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGivenMemberWithOpenLoansWhenDeleteInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	id := uuid.New()
+	m.members.On("Delete", id).Return(model.ErrMemberHasOpenLoans)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/members/"+id.String(), nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Delete)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenEmailTakenWhenRestoreInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	id := uuid.New()
+	m.members.On("Restore", id).Return((*model.Member)(nil), model.ErrMemberEmailConflict)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members/"+id.String()+"/restore", nil)
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Restore)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenUnknownMemberWhenUpdateInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	id := uuid.New()
+	m.members.On("Update", id, mock.AnythingOfType("*model.Member")).Return((*model.Member)(nil), model.ErrMemberNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PUT", "/members/"+id.String(), bytes.NewBufferString(`{"phone":"+1-555-0000"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.Update)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenEmptyBodyFieldsWhenCreateInControllerThenListEveryField(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newMemberController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/members", bytes.NewBufferString(`{"phone":"555"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"full_name"`)
+	assert.Contains(t, w.Body.String(), `"field":"email"`)
+	m.members.AssertNotCalled(t, "Save", mock.Anything)
+}
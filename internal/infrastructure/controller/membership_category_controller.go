@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+)
+
+// categoryTermsRequest is the body of PUT /membership-categories/:name, which
+// replaces every term of the category.
+type categoryTermsRequest struct {
+	MaxLoans           int `json:"max_loans" binding:"gt=0"`
+	LoanPeriodDays     int `json:"loan_period_days" binding:"gt=0"`
+	RenewalLimit       int `json:"renewal_limit" binding:"gte=0"`
+	FineDailyRateCents int `json:"fine_daily_rate_cents" binding:"gte=0"`
+	FineGraceDays      int `json:"fine_grace_days" binding:"gte=0"`
+	FineMaxCents       int `json:"fine_max_cents" binding:"gte=0"`
+}
+
+type createCategoryRequest struct {
+	Name string `json:"name" binding:"required,notblank"`
+	categoryTermsRequest
+}
+
+func (r *categoryTermsRequest) toModel(name string) *model.MembershipCategory {
+	return &model.MembershipCategory{
+		Name:               name,
+		MaxLoans:           r.MaxLoans,
+		LoanPeriodDays:     r.LoanPeriodDays,
+		RenewalLimit:       r.RenewalLimit,
+		FineDailyRateCents: r.FineDailyRateCents,
+		FineGraceDays:      r.FineGraceDays,
+		FineMaxCents:       r.FineMaxCents,
+	}
+}
+
+type MembershipCategoryController struct {
+	app application.MembershipCategoryUseCaseInterface
+}
+
+func NewMembershipCategoryController(app application.MembershipCategoryUseCaseInterface) *MembershipCategoryController {
+	return &MembershipCategoryController{app}
+}
+
+func (c *MembershipCategoryController) Create(ctx *gin.Context) {
+	var req createCategoryRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert membership category", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	category := req.toModel(req.Name)
+	if err := c.app.CreateCategoryUseCase(ctx.Request.Context(), category); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to create membership category", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, category)
+}
+
+func (c *MembershipCategoryController) GetAll(ctx *gin.Context) {
+	query, err := listQuery(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get list parameters", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	page, err := c.app.GetCategoriesUseCase(ctx.Request.Context(), query)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find membership categories", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, newListResponse(ctx, query, page))
+}
+
+func (c *MembershipCategoryController) GetByName(ctx *gin.Context) {
+	category, err := c.app.GetCategoryUseCase(ctx.Request.Context(), ctx.Param("name"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get membership category", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, category)
+}
+
+func (c *MembershipCategoryController) Update(ctx *gin.Context) {
+	var req categoryTermsRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert membership category", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	updated, err := c.app.UpdateCategoryUseCase(ctx.Request.Context(), ctx.Param("name"), req.toModel(ctx.Param("name")))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to update membership category", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, updated)
+}
@@ -0,0 +1,119 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func newMembershipCategoryController() (*controller.MembershipCategoryController, *mockRepo.MembershipCategoryRepoMock) {
+	repo := new(mockRepo.MembershipCategoryRepoMock)
+	svc := service.NewMembershipCategoryService(repo)
+	return controller.NewMembershipCategoryController(application.NewMembershipCategoryUseCase(svc)), repo
+}
+
+func TestGivenCategoryWhenCreateInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newMembershipCategoryController()
+	repo.On("Save", mock.AnythingOfType("*model.MembershipCategory")).Return(nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/membership-categories", bytes.NewBufferString(
+		`{"name":"Staff","max_loans":10,"loan_period_days":28,"renewal_limit":3,"fine_daily_rate_cents":0}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"staff"`)
+}
+
+func TestGivenInvalidTermsWhenCreateInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newMembershipCategoryController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/membership-categories",
+		bytes.NewBufferString(`{"name":"staff","max_loans":0,"loan_period_days":28}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"max_loans"`)
+}
+
+func TestGivenDuplicateNameWhenCreateInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newMembershipCategoryController()
+	repo.On("Save", mock.AnythingOfType("*model.MembershipCategory")).Return(model.ErrMembershipCategoryConflict)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/membership-categories",
+		bytes.NewBufferString(`{"name":"student","max_loans":3,"loan_period_days":21}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	serve(c, ctrl.Create)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenCategoriesWhenGetAllInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newMembershipCategoryController()
+	categories := []model.MembershipCategory{*builder.NewMembershipCategoryBuilder().Build()}
+	repo.On("FindAll", mock.Anything).Return(&port.Page[model.MembershipCategory]{Items: categories, Total: 1}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/membership-categories", nil)
+
+	serve(c, ctrl.GetAll)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"max_loans":3`)
+}
+
+func TestGivenUnknownNameWhenGetByNameInControllerThenReturnNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newMembershipCategoryController()
+	repo.On("FindByName", "vip").Return((*model.MembershipCategory)(nil), model.ErrMembershipCategoryNotFound)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/membership-categories/vip", nil)
+	c.Params = []gin.Param{{Key: "name", Value: "vip"}}
+
+	serve(c, ctrl.GetByName)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGivenNewTermsWhenUpdateInControllerThenReturnOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newMembershipCategoryController()
+	updated := builder.NewMembershipCategoryBuilder().WithMaxLoans(4).Build()
+	repo.On("Update", "student", mock.AnythingOfType("*model.MembershipCategory")).Return(updated, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("PUT", "/membership-categories/student",
+		bytes.NewBufferString(`{"max_loans":4,"loan_period_days":21,"renewal_limit":1,"fine_daily_rate_cents":10}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "name", Value: "student"}}
+
+	serve(c, ctrl.Update)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"max_loans":4`)
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"library/internal/domain/domainerror"
+)
+
+const problemContentType = "application/problem+json"
+
+var (
+	errInvalidID             = domainerror.NewValidation("invalid_id", "Invalid ID")
+	errInvalidBody           = domainerror.NewValidation("invalid_body", "request body is not valid JSON for this resource")
+	errInternal              = domainerror.New(domainerror.Internal, "internal_error", "internal server error")
+	errUnsupportedMergePatch = withStatus(http.StatusUnsupportedMediaType,
+		domainerror.NewValidation("unsupported_media_type", "Content-Type must be "+mergePatchContentType))
+)
+
+// Problem is an RFC 7807 problem details object. Code identifies the domain
+// error so clients need not parse Detail; Errors lists the invalid fields of
+// a rejected request body.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail"`
+	Instance string       `json:"instance"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// ErrorHandler renders the last error a handler attached with ctx.Error as an
+// application/problem+json response. Errors without a domain kind are
+// reported as a bare 500, their message stays in the logs.
+func ErrorHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		last := ctx.Errors.Last()
+		if last == nil || ctx.Writer.Written() {
+			return
+		}
+		problem := problemFor(last.Err)
+		problem.Instance = ctx.Request.URL.Path
+		if problem.Status == http.StatusInternalServerError {
+			slog.ErrorContext(ctx.Request.Context(), "Unhandled error", "error", last.Err, "path", problem.Instance)
+		}
+		ctx.Header("Content-Type", problemContentType)
+		ctx.JSON(problem.Status, problem)
+	}
+}
+
+func problemFor(err error) Problem {
+	domainErr, ok := domainerror.As(err)
+	if !ok || domainErr.Kind() == domainerror.Internal {
+		domainErr = errInternal
+	}
+	status := errorStatus(err)
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: domainErr.Error(),
+		Code:   domainErr.Code(),
+	}
+	var invalid *invalidFieldsError
+	if errors.As(err, &invalid) {
+		problem.Errors = invalid.fields
+	}
+	return problem
+}
+
+// errorStatus maps the kind of a domain error to the HTTP status returned to
+// clients.
+func errorStatus(err error) int {
+	var override *statusError
+	if errors.As(err, &override) {
+		return override.status
+	}
+	switch domainerror.KindOf(err) {
+	case domainerror.NotFound:
+		return http.StatusNotFound
+	case domainerror.Conflict:
+		return http.StatusConflict
+	case domainerror.Validation:
+		return http.StatusBadRequest
+	case domainerror.Forbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// statusError reports err with a status its kind does not imply, for
+// transport-level failures such as an unsupported media type.
+type statusError struct {
+	status int
+	err    error
+}
+
+func withStatus(status int, err error) error {
+	return &statusError{status: status, err: err}
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,83 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/domain/model"
+	"library/internal/infrastructure/controller"
+)
+
+// serve runs handler the way the router does, behind ErrorHandler.
+func serve(c *gin.Context, handler gin.HandlerFunc) {
+	handler(c)
+	controller.ErrorHandler()(c)
+}
+
+func TestGivenDomainErrorWhenErrorHandlerThenRenderProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/authors/42", nil)
+
+	serve(c, func(ctx *gin.Context) { _ = ctx.Error(model.ErrAuthorNotFound) })
+
+	var problem controller.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Equal(t, controller.Problem{
+		Type:     "about:blank",
+		Title:    "Not Found",
+		Status:   http.StatusNotFound,
+		Detail:   "author not found",
+		Instance: "/authors/42",
+		Code:     "author_not_found",
+	}, problem)
+}
+
+func TestGivenForbiddenErrorWhenErrorHandlerThenReturnForbidden(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/loans", nil)
+
+	serve(c, func(ctx *gin.Context) { _ = ctx.Error(model.ErrMemberInactive) })
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"member_inactive"`)
+}
+
+func TestGivenUnknownErrorWhenErrorHandlerThenHideDetail(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/books", nil)
+
+	serve(c, func(ctx *gin.Context) { _ = ctx.Error(errors.New("pq: connection refused")) })
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Body.String(), "connection refused")
+	assert.Contains(t, w.Body.String(), `"detail":"internal server error"`)
+}
+
+func TestGivenWrittenResponseWhenErrorHandlerThenKeepResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/books", nil)
+
+	serve(c, func(ctx *gin.Context) {
+		_ = ctx.Error(model.ErrBookNotFound)
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "{}", w.Body.String())
+}
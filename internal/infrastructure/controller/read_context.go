@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"library/internal/domain/domainerror"
+	"library/internal/domain/port"
+)
+
+var (
+	errInvalidIncludeDeleted   = domainerror.NewValidation("invalid_include_deleted_flag", "Invalid include_deleted flag")
+	errIncludeDeletedForbidden = domainerror.NewForbidden("include_deleted_forbidden",
+		"only administrators can read deleted records")
+)
+
+// readContext returns the context for a lookup. Sending include_deleted=true
+// also returns soft-deleted authors, books, copies and members, which only an
+// administrator may do (see AdminAuth). On an invalid or forbidden flag it
+// records the error for ErrorHandler and returns false.
+func readContext(ctx *gin.Context) (context.Context, bool) {
+	include, err := strconv.ParseBool(ctx.DefaultQuery("include_deleted", "false"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get include_deleted parameter", "error", err)
+		ctx.Error(errInvalidIncludeDeleted)
+		return nil, false
+	}
+	if !include {
+		return ctx.Request.Context(), true
+	}
+	if !isAdmin(ctx.Request.Context()) {
+		ctx.Error(errIncludeDeletedForbidden)
+		return nil, false
+	}
+	return port.WithDeleted(ctx.Request.Context()), true
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/infrastructure/logging"
+)
+
+type ReservationController struct {
+	app application.ReservationUseCaseInterface
+}
+
+type placeHoldRequest struct {
+	MemberID uuid.UUID `json:"member_id" binding:"required"`
+}
+
+func NewReservationController(app application.ReservationUseCaseInterface) *ReservationController {
+	return &ReservationController{app}
+}
+
+func (c *ReservationController) PlaceHold(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	var req placeHoldRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to convert reservation", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	logging.AddAttrs(ctx.Request.Context(), slog.String("member_id", req.MemberID.String()))
+	reservation, err := c.app.PlaceHoldUseCase(ctx.Request.Context(), bookID, req.MemberID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to place hold", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, reservation)
+}
+
+func (c *ReservationController) GetBookQueue(ctx *gin.Context) {
+	bookID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reservations, err := c.app.GetBookQueueUseCase(ctx.Request.Context(), bookID)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find book reservations", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, reservations)
+}
+
+func (c *ReservationController) GetById(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reservation, err := c.app.GetReservationUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get reservation", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, reservation)
+}
+
+func (c *ReservationController) Cancel(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get parameter", "error", err)
+		ctx.Error(errInvalidID)
+		return
+	}
+
+	reservation, err := c.app.CancelHoldUseCase(ctx.Request.Context(), id)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to cancel reservation", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, reservation)
+}
+
+func (c *ReservationController) GetHoldShelf(ctx *gin.Context) {
+	reservations, err := c.app.GetHoldShelfUseCase(ctx.Request.Context())
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to find hold shelf", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, reservations)
+}
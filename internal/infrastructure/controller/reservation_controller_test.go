@@ -0,0 +1,160 @@
+package controller_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+type reservationControllerMocks struct {
+	repo    *mockRepo.ReservationRepoMock
+	books   *mockRepo.BookRepoMock
+	members *mockRepo.MemberRepoMock
+}
+
+func newReservationController() (*controller.ReservationController, reservationControllerMocks) {
+	m := reservationControllerMocks{
+		repo:    new(mockRepo.ReservationRepoMock),
+		books:   new(mockRepo.BookRepoMock),
+		members: new(mockRepo.MemberRepoMock),
+	}
+	svc := service.NewReservationService(
+		mockRepo.TransactionMock{}, m.repo, m.books, m.members, nil, service.DefaultLoanPolicy())
+	return controller.NewReservationController(application.NewReservationUseCase(svc)), m
+}
+
+func TestGivenActiveMemberWhenPlaceHoldInControllerThenReturnStatusCreated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	member := builder.NewMemberBuilder().Build()
+	book := builder.NewBookBuilder().Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.repo.On("Save", mock.AnythingOfType("*model.Reservation")).Return(nil)
+	m.repo.On("Position", mock.AnythingOfType("*model.Reservation")).Return(2, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/books/"+book.ID.String()+"/reservations",
+		bytes.NewBufferString(`{"member_id":"`+member.ID.String()+`"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+
+	serve(c, ctrl.PlaceHold)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"position":2`)
+}
+
+func TestGivenMissingMemberWhenPlaceHoldInControllerThenReturnUnprocessableEntity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, _ := newReservationController()
+	id := uuid.New()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/books/"+id.String()+"/reservations", bytes.NewBufferString(`{}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: id.String()}}
+
+	serve(c, ctrl.PlaceHold)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"member_id"`)
+}
+
+func TestGivenDuplicateHoldWhenPlaceHoldInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	member := builder.NewMemberBuilder().Build()
+	book := builder.NewBookBuilder().Build()
+	m.members.On("FindById", member.ID).Return(member, nil)
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.repo.On("Save", mock.AnythingOfType("*model.Reservation")).Return(model.ErrReservationAlreadyActive)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/books/"+book.ID.String()+"/reservations",
+		bytes.NewBufferString(`{"member_id":"`+member.ID.String()+`"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+
+	serve(c, ctrl.PlaceHold)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGivenReservationWhenGetByIdInControllerThenReturnPosition(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	reservation := builder.NewReservationBuilder().Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+	m.repo.On("Position", reservation).Return(4, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/reservations/"+reservation.ID.String(), nil)
+	c.Params = []gin.Param{{Key: "id", Value: reservation.ID.String()}}
+
+	serve(c, ctrl.GetById)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"position":4`)
+}
+
+func TestGivenBookQueueWhenGetBookQueueInControllerThenReturnStatusOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	book := builder.NewBookBuilder().Build()
+	m.books.On("FindById", book.ID).Return(book, nil)
+	m.repo.On("FindActiveByBook", book.ID).Return([]model.Reservation{}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/books/"+book.ID.String()+"/reservations", nil)
+	c.Params = []gin.Param{{Key: "id", Value: book.ID.String()}}
+
+	serve(c, ctrl.GetBookQueue)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGivenCanceledReservationWhenCancelInControllerThenReturnConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	reservation := builder.NewReservationBuilder().WithCanceledAt(time.Now()).Build()
+	m.repo.On("FindById", reservation.ID).Return(reservation, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/reservations/"+reservation.ID.String()+"/cancel", nil)
+	c.Params = []gin.Param{{Key: "id", Value: reservation.ID.String()}}
+
+	serve(c, ctrl.Cancel)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), model.ErrReservationNotActive.Error())
+}
+
+func TestGivenCopiesOnHoldShelfWhenGetHoldShelfInControllerThenReturnStatusOK(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, m := newReservationController()
+	hold := builder.NewReservationBuilder().WithHeldCopy(uuid.New(), time.Now().AddDate(0, 0, 2)).Build()
+	m.repo.On("FindHoldShelf").Return([]model.Reservation{*hold}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/reservations/hold-shelf", nil)
+
+	serve(c, ctrl.GetHoldShelf)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), hold.HeldCopyID.String())
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type SearchController struct {
+	app application.SearchUseCaseInterface
+}
+
+func NewSearchController(app application.SearchUseCaseInterface) *SearchController {
+	return &SearchController{app}
+}
+
+// Search serves GET /search?q=. Results are paged with page and size and can
+// be narrowed to one facet with year and author_id.
+func (c *SearchController) Search(ctx *gin.Context) {
+	query, err := searchQuery(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to get search parameters", "error", err)
+		ctx.Error(err)
+		return
+	}
+
+	result, err := c.app.SearchUseCase(ctx.Request.Context(), query)
+	if err != nil {
+		slog.ErrorContext(ctx.Request.Context(), "Error trying to search the catalog", "error", err)
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+func searchQuery(ctx *gin.Context) (port.SearchQuery, error) {
+	query := port.SearchQuery{Text: ctx.Query("q")}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		return query, errInvalidPage
+	}
+	query.Page = page
+	size, err := strconv.Atoi(ctx.DefaultQuery("size", strconv.Itoa(port.DefaultListLimit)))
+	if err != nil || size < 1 || size > port.MaxListLimit {
+		return query, errInvalidLimit
+	}
+	query.Limit = size
+
+	if value, ok := ctx.GetQuery("year"); ok {
+		year, err := strconv.Atoi(value)
+		if err != nil {
+			return query, model.ErrInvalidFilter
+		}
+		query.Year = &year
+	}
+	if value, ok := ctx.GetQuery("author_id"); ok {
+		authorID, err := uuid.Parse(value)
+		if err != nil {
+			return query, model.ErrInvalidFilter
+		}
+		query.AuthorID = &authorID
+	}
+	return query, nil
+}
@@ -0,0 +1,83 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/controller"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func newSearchController() (*controller.SearchController, *mockRepo.SearchRepoMock) {
+	repo := new(mockRepo.SearchRepoMock)
+	svc := service.NewSearchService(repo)
+	return controller.NewSearchController(application.NewSearchUseCase(svc)), repo
+}
+
+func TestGivenQueryWhenSearchInControllerThenReturnHitsAndFacets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newSearchController()
+	book := builder.NewBookBuilder().Build()
+	authorID := uuid.New()
+	year := 1965
+	repo.On("SearchBooks", port.SearchQuery{Text: `"left hand" ursu*`, Year: &year, AuthorID: &authorID, Limit: 5, Page: 2}).
+		Return(&model.SearchResult{
+			Total: 6,
+			Hits:  []model.SearchHit{{Book: *book, Rank: 0.5, Highlights: model.SearchHighlights{Title: "<mark>Left</mark>"}}},
+			Facets: model.SearchFacets{
+				PublishedYears: []model.YearFacet{{Year: 1965, Count: 6}},
+				Authors:        []model.AuthorFacet{},
+			},
+		}, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET",
+		`/search?q=%22left+hand%22+ursu*&year=1965&author_id=`+authorID.String()+`&page=2&size=5`, nil)
+
+	serve(c, ctrl.Search)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"total":6`)
+	assert.Contains(t, w.Body.String(), `"title":"\u003cmark\u003eLeft\u003c/mark\u003e"`)
+	assert.Contains(t, w.Body.String(), `"published_years":[{"year":1965,"count":6}]`)
+	repo.AssertExpectations(t)
+}
+
+func TestGivenMissingQueryWhenSearchInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newSearchController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/search", nil)
+
+	serve(c, ctrl.Search)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_search_query"`)
+	repo.AssertNotCalled(t, "SearchBooks", mock.Anything)
+}
+
+func TestGivenInvalidFacetWhenSearchInControllerThenReturnBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl, repo := newSearchController()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/search?q=dune&author_id=herbert", nil)
+
+	serve(c, ctrl.Search)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid_filter"`)
+	repo.AssertNotCalled(t, "SearchBooks", mock.Anything)
+}
@@ -0,0 +1,171 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+
+	"library/internal/domain/domainerror"
+)
+
+var errInvalidFields = domainerror.NewValidation("invalid_fields", "request has invalid fields")
+
+// Request bodies are bound into the request types of each controller, never
+// into the GORM models, and checked with their binding tags. Besides the
+// validator built-ins the rules notblank, isbn and future are available.
+func init() {
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	engine.RegisterTagNameFunc(jsonFieldName)
+	_ = engine.RegisterValidation("notblank", validators.NotBlank)
+	_ = engine.RegisterValidation("isbn", validISBN)
+	_ = engine.RegisterValidation("future", inFuture)
+}
+
+// FieldError describes one invalid field of a request body.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// invalidFieldsError lists every field of a request body that broke its
+// rules. It is reported as 422 with the fields in the problem response.
+type invalidFieldsError struct {
+	fields []FieldError
+}
+
+func newInvalidFields(fields []FieldError) error {
+	return withStatus(http.StatusUnprocessableEntity, &invalidFieldsError{fields: fields})
+}
+
+func (e *invalidFieldsError) Error() string {
+	names := make([]string, len(e.fields))
+	for i, field := range e.fields {
+		names[i] = field.Field
+	}
+	return "invalid fields: " + strings.Join(names, ", ")
+}
+
+func (e *invalidFieldsError) Unwrap() error {
+	return errInvalidFields
+}
+
+// bindJSON decodes the request body into req and checks its binding rules.
+// Malformed JSON is errInvalidBody; values of the wrong type or breaking a
+// rule are reported together as invalid fields.
+func bindJSON(ctx *gin.Context, req any) error {
+	err := ctx.ShouldBindJSON(req)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return newInvalidFields(fieldErrorsFrom(validationErrs))
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return newInvalidFields([]FieldError{{
+			Field:   typeErr.Field,
+			Code:    "invalid_type",
+			Message: "must be a " + typeErr.Type.String(),
+		}})
+	}
+	return errInvalidBody
+}
+
+func fieldErrorsFrom(validationErrs validator.ValidationErrors) []FieldError {
+	fields := make([]FieldError, len(validationErrs))
+	for i, fieldErr := range validationErrs {
+		fields[i] = FieldError{Field: fieldErr.Field(), Code: fieldErr.Tag(), Message: fieldMessage(fieldErr)}
+	}
+	return fields
+}
+
+func fieldMessage(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "notblank":
+		return "must not be blank"
+	case "max":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters", fieldErr.Param())
+		}
+		return "must be at most " + fieldErr.Param()
+	case "gte":
+		return "must be at least " + fieldErr.Param()
+	case "gt":
+		return "must be greater than " + fieldErr.Param()
+	case "email":
+		return "must be a valid email address"
+	case "isbn":
+		return "must be a valid ISBN-10 or ISBN-13"
+	case "future":
+		return "must be in the future"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fieldErr.Param(), " ", ", ")
+	default:
+		return "is invalid"
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// validISBN accepts an ISBN-10 or ISBN-13 with a correct check digit. Hyphens
+// and spaces between the digits are ignored.
+func validISBN(fl validator.FieldLevel) bool {
+	isbn := strings.NewReplacer("-", "", " ", "").Replace(fl.Field().String())
+	switch len(isbn) {
+	case 10:
+		sum := 0
+		for i, r := range isbn {
+			digit := int(r - '0')
+			if i == 9 && (r == 'X' || r == 'x') {
+				digit = 10
+			} else if r < '0' || r > '9' {
+				return false
+			}
+			sum += (10 - i) * digit
+		}
+		return sum%11 == 0
+	case 13:
+		sum := 0
+		for i, r := range isbn {
+			if r < '0' || r > '9' {
+				return false
+			}
+			weight := 1
+			if i%2 == 1 {
+				weight = 3
+			}
+			sum += weight * int(r-'0')
+		}
+		return sum%10 == 0
+	default:
+		return false
+	}
+}
+
+func inFuture(fl validator.FieldLevel) bool {
+	value, ok := fl.Field().Interface().(time.Time)
+	return ok && value.After(time.Now())
+}
@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// unavailable is the reason given for a component that is down. The probe is
+// public, so the checker's error is logged instead of returned.
+const unavailable = "unavailable"
+
+// Checker reports whether a dependency of the service can be used.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a function, such as (*sql.DB).PingContext, to Checker.
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Report is the outcome of a readiness check: up only when every component is.
+type Report struct {
+	Status     string               `json:"status"`
+	Components map[string]Component `json:"components"`
+}
+
+// Component is the outcome of one checker. Error is set when it is down.
+type Component struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Readiness runs the registered checkers concurrently, each bounded by the
+// timeout.
+type Readiness struct {
+	timeout  time.Duration
+	names    []string
+	checkers []Checker
+}
+
+func NewReadiness(timeout time.Duration) *Readiness {
+	return &Readiness{timeout: timeout}
+}
+
+// Register adds a checker reported under name. It is not safe to call once
+// the service handles requests.
+func (r *Readiness) Register(name string, checker Checker) {
+	r.names = append(r.names, name)
+	r.checkers = append(r.checkers, checker)
+}
+
+// Check runs every checker and reports each one.
+func (r *Readiness) Check(ctx context.Context) Report {
+	components := make([]Component, len(r.checkers))
+	var wg sync.WaitGroup
+	for i, checker := range r.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = r.run(ctx, r.names[i], checker)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Components: make(map[string]Component, len(components))}
+	for i, component := range components {
+		if component.Status == StatusDown {
+			report.Status = StatusDown
+		}
+		report.Components[r.names[i]] = component
+	}
+	return report
+}
+
+func (r *Readiness) run(ctx context.Context, name string, checker Checker) Component {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	start := time.Now()
+	err := checker.Check(ctx)
+	component := Component{Status: StatusUp, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		component.Status = StatusDown
+		component.Error = unavailable
+		slog.WarnContext(ctx, "Readiness check failed", "component", name, "error", err)
+	}
+	return component
+}
@@ -0,0 +1,54 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"library/internal/infrastructure/health"
+)
+
+func up(context.Context) error { return nil }
+
+func TestGivenHealthyCheckersWhenCheckThenReportUp(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Register("database", health.CheckerFunc(up))
+	readiness.Register("migrations", health.CheckerFunc(up))
+
+	report := readiness.Check(t.Context())
+
+	assert.Equal(t, health.StatusUp, report.Status)
+	assert.Equal(t, health.StatusUp, report.Components["database"].Status)
+	assert.Equal(t, health.StatusUp, report.Components["migrations"].Status)
+}
+
+func TestGivenFailingCheckerWhenCheckThenReportItDown(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Register("database", health.CheckerFunc(up))
+	readiness.Register("migrations", health.CheckerFunc(func(context.Context) error {
+		return errors.New("2 of 10 not applied")
+	}))
+
+	report := readiness.Check(t.Context())
+
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, health.StatusUp, report.Components["database"].Status)
+	assert.Equal(t, health.Component{Status: health.StatusDown, Error: "unavailable"},
+		report.Components["migrations"])
+}
+
+func TestGivenHangingCheckerWhenCheckThenReportDownAfterTimeout(t *testing.T) {
+	readiness := health.NewReadiness(20 * time.Millisecond)
+	readiness.Register("database", health.CheckerFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	report := readiness.Check(t.Context())
+
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, "unavailable", report.Components["database"].Error)
+}
@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Formats a log line can be written in.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// redacted replaces the value of personal data in log lines.
+const redacted = "[REDACTED]"
+
+var Formats = []string{FormatJSON, FormatText}
+
+// piiKeys are the words that mark an attribute as personal data, matched
+// against the lower-cased key.
+var piiKeys = []string{"email", "phone", "password", "secret", "token", "authorization"}
+
+var emailAddress = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// Options selects the format and the lowest level of the log lines.
+type Options struct {
+	Format string
+	Level  slog.Level
+}
+
+// New returns a logger writing to w that adds the attributes carried by the
+// context of each record, and the trace and span IDs of its span, and redacts
+// personal data.
+func New(w io.Writer, opts Options) (*slog.Logger, error) {
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level, ReplaceAttr: redact}
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, handlerOpts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, handlerOpts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", opts.Format)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+// fields are the attributes of a request, shared by every context derived
+// from the one AddAttrs was first called with so that attributes added deep
+// in a handler still reach the access log line.
+type fields struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+type fieldsKey struct{}
+
+// WithFields returns a context whose log records carry attrs and any
+// attribute later added to it with AddAttrs.
+func WithFields(ctx context.Context, attrs ...slog.Attr) context.Context {
+	return context.WithValue(ctx, fieldsKey{}, &fields{attrs: attrs})
+}
+
+// AddAttrs adds attrs to the records logged with ctx and every context it
+// shares its fields with. It does nothing unless ctx comes from WithFields.
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	f, ok := ctx.Value(fieldsKey{}).(*fields)
+	if !ok {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attrs = append(f.attrs, attrs...)
+}
+
+func attrsOf(ctx context.Context) []slog.Attr {
+	f, ok := ctx.Value(fieldsKey{}).(*fields)
+	if !ok {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]slog.Attr(nil), f.attrs...)
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx != nil {
+		record.AddAttrs(attrsOf(ctx)...)
+		if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+			record.AddAttrs(
+				slog.String("trace_id", span.TraceID().String()),
+				slog.String("span_id", span.SpanID().String()),
+			)
+		}
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// redact hides the value of attributes named like personal data and the
+// email addresses inside strings and errors, such as a unique violation
+// quoting the conflicting email.
+func redact(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Value.Kind() == slog.KindGroup {
+		return attr
+	}
+	key := strings.ToLower(attr.Key)
+	for _, word := range piiKeys {
+		if strings.Contains(key, word) {
+			return slog.String(attr.Key, redacted)
+		}
+	}
+	switch value := attr.Value.Any().(type) {
+	case string:
+		if emailAddress.MatchString(value) {
+			return slog.String(attr.Key, emailAddress.ReplaceAllString(value, redacted))
+		}
+	case error:
+		if message := value.Error(); emailAddress.MatchString(message) {
+			return slog.String(attr.Key, emailAddress.ReplaceAllString(message, redacted))
+		}
+	}
+	return attr
+}
@@ -0,0 +1,128 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"library/internal/infrastructure/logging"
+)
+
+// captureLogs makes a JSON logger writing to the returned buffer the default
+// for the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, logging.Options{Format: logging.FormatJSON, Level: slog.LevelDebug})
+	require.NoError(t, err)
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func lines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestGivenContextFieldsWhenLogThenAddThemToTheRecord(t *testing.T) {
+	buf := captureLogs(t)
+	ctx := logging.WithFields(t.Context(), slog.String("request_id", "abc"))
+	logging.AddAttrs(ctx, slog.String("member_id", "42"))
+
+	slog.InfoContext(ctx, "Loan save successful")
+
+	record := lines(t, buf)[0]
+	assert.Equal(t, "abc", record["request_id"])
+	assert.Equal(t, "42", record["member_id"])
+}
+
+func TestGivenPersonalDataWhenLogThenRedactIt(t *testing.T) {
+	buf := captureLogs(t)
+
+	slog.Error("Failed to save member",
+		"email", "ana@example.com",
+		"phone", "+57 300 000 0000",
+		"error", errors.New(`duplicate key: Key (email)=(ana@example.com) already exists`))
+
+	record := lines(t, buf)[0]
+	assert.Equal(t, "[REDACTED]", record["email"])
+	assert.Equal(t, "[REDACTED]", record["phone"])
+	assert.Equal(t, "duplicate key: Key (email)=([REDACTED]) already exists", record["error"])
+	assert.NotContains(t, buf.String(), "ana@example.com")
+}
+
+func TestGivenUnknownFormatWhenNewThenFail(t *testing.T) {
+	_, err := logging.New(&bytes.Buffer{}, logging.Options{Format: "xml"})
+
+	assert.ErrorContains(t, err, `unknown log format "xml"`)
+}
+
+func TestGivenTextFormatWhenLogThenWriteKeyValuePairs(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, logging.Options{Format: logging.FormatText})
+	require.NoError(t, err)
+
+	logger.Info("Book save successful", "book_id", "b1")
+
+	assert.Contains(t, buf.String(), `msg="Book save successful" book_id=b1`)
+}
+
+func serveWithMiddleware(t *testing.T, req *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(logging.Middleware())
+	r.GET("/api/v1/library/members/:id", func(ctx *gin.Context) {
+		slog.InfoContext(ctx.Request.Context(), "Member found")
+		ctx.Status(http.StatusOK)
+	})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestGivenRequestIDWhenServeThenPropagateItToLogsAndResponse(t *testing.T) {
+	buf := captureLogs(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/library/members/42", nil)
+	req.Header.Set(logging.RequestIDHeader, "req-123")
+
+	w := serveWithMiddleware(t, req)
+
+	assert.Equal(t, "req-123", w.Header().Get(logging.RequestIDHeader))
+	records := lines(t, buf)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		assert.Equal(t, "req-123", record["request_id"])
+		assert.Equal(t, "/api/v1/library/members/:id", record["route"])
+		assert.Equal(t, "42", record["member_id"])
+	}
+	assert.Equal(t, "Request served", records[1]["msg"])
+	assert.EqualValues(t, http.StatusOK, records[1]["status"])
+	assert.Contains(t, records[1], "latency_ms")
+}
+
+func TestGivenInvalidRequestIDWhenServeThenGenerateOne(t *testing.T) {
+	captureLogs(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/library/members/42", nil)
+	req.Header.Set(logging.RequestIDHeader, "bad id\nlevel=ERROR")
+
+	w := serveWithMiddleware(t, req)
+
+	id := w.Header().Get(logging.RequestIDHeader)
+	assert.Len(t, id, 36)
+	assert.NotContains(t, id, "bad")
+}
@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the ID that correlates the log lines of a request.
+const RequestIDHeader = "X-Request-ID"
+
+// requestID is the shape accepted from clients, so that a header cannot
+// inject anything into the logs.
+var requestID = regexp.MustCompile(`^[A-Za-z0-9._\-]{1,128}$`)
+
+// Middleware takes the request ID from X-Request-ID, or generates one, and
+// echoes it in the response. Every record logged with the request context
+// carries the request ID, method and route template, and the member ID on
+// member routes. When the request is served it logs a line with the status
+// and latency.
+func Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		id := ctx.GetHeader(RequestIDHeader)
+		if !requestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+		ctx.Header(RequestIDHeader, id)
+
+		attrs := []slog.Attr{
+			slog.String("request_id", id),
+			slog.String("method", ctx.Request.Method),
+			slog.String("route", ctx.FullPath()),
+		}
+		if strings.HasPrefix(ctx.FullPath(), "/api/v1/library/members/:id") {
+			attrs = append(attrs, slog.String("member_id", ctx.Param("id")))
+		}
+		reqCtx := WithFields(ctx.Request.Context(), attrs...)
+		ctx.Request = ctx.Request.WithContext(reqCtx)
+
+		ctx.Next()
+
+		level := slog.LevelInfo
+		if ctx.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+		slog.Log(reqCtx, level, "Request served",
+			"status", ctx.Writer.Status(),
+			"latency_ms", time.Since(start).Milliseconds(),
+		)
+	}
+}
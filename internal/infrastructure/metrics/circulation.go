@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+)
+
+// overdueLoansTimeout bounds the query counting overdue loans on a scrape.
+const overdueLoansTimeout = 5 * time.Second
+
+// LoanUseCase counts the checkouts and returns that go through next.
+func (m *Metrics) LoanUseCase(next application.LoanUseCaseInterface) application.LoanUseCaseInterface {
+	return &loanUseCase{LoanUseCaseInterface: next, metrics: m}
+}
+
+// ReservationUseCase counts the holds placed and expired through next.
+func (m *Metrics) ReservationUseCase(next application.ReservationUseCaseInterface) application.ReservationUseCaseInterface {
+	return &reservationUseCase{ReservationUseCaseInterface: next, metrics: m}
+}
+
+// TrackOverdueLoans exports how many loans are overdue, counted through loans
+// on every scrape.
+func (m *Metrics) TrackOverdueLoans(loans application.LoanUseCaseInterface) error {
+	return m.registry.Register(&overdueLoans{
+		loans: loans,
+		desc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "loans_overdue"),
+			"Loans not returned by the end of their due date.", nil, nil),
+	})
+}
+
+type overdueLoans struct {
+	loans application.LoanUseCaseInterface
+	desc  *prometheus.Desc
+}
+
+func (c *overdueLoans) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect leaves the gauge out of the scrape when the count fails, so that a
+// database outage does not fail the other metrics.
+func (c *overdueLoans) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), overdueLoansTimeout)
+	defer cancel()
+	count, err := c.loans.CountOverdueUseCase(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to count overdue loans for metrics", "error", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count))
+}
+
+type loanUseCase struct {
+	application.LoanUseCaseInterface
+	metrics *Metrics
+}
+
+func (u *loanUseCase) CheckoutUseCase(
+	ctx context.Context,
+	memberID, copyID uuid.UUID,
+	dueDate *time.Time,
+) (*model.Loan, error) {
+	loan, err := u.LoanUseCaseInterface.CheckoutUseCase(ctx, memberID, copyID, dueDate)
+	if err == nil {
+		u.metrics.checkouts.Inc()
+	}
+	return loan, err
+}
+
+func (u *loanUseCase) ReturnUseCase(ctx context.Context, copyID uuid.UUID) (*model.Loan, error) {
+	loan, err := u.LoanUseCaseInterface.ReturnUseCase(ctx, copyID)
+	if err != nil {
+		return loan, err
+	}
+	u.metrics.returns.Inc()
+	if loan.ReturnedAt != nil && service.OverdueDays(loan.DueDate, *loan.ReturnedAt) > 0 {
+		u.metrics.overdueReturns.Inc()
+	}
+	return loan, nil
+}
+
+type reservationUseCase struct {
+	application.ReservationUseCaseInterface
+	metrics *Metrics
+}
+
+func (u *reservationUseCase) PlaceHoldUseCase(ctx context.Context, bookID, memberID uuid.UUID) (*model.Reservation, error) {
+	reservation, err := u.ReservationUseCaseInterface.PlaceHoldUseCase(ctx, bookID, memberID)
+	if err == nil {
+		u.metrics.holdsPlaced.Inc()
+	}
+	return reservation, err
+}
+
+func (u *reservationUseCase) ExpireStaleHoldsUseCase(ctx context.Context) (int, error) {
+	expired, err := u.ReservationUseCaseInterface.ExpireStaleHoldsUseCase(ctx)
+	u.metrics.holdsExpired.Add(float64(expired))
+	return expired, err
+}
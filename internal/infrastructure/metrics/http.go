@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute labels requests that match no route, so that probing random
+// paths cannot create a series per path.
+const unmatchedRoute = "unmatched"
+
+// Middleware counts and times every request under its route template, such
+// as /api/v1/library/books/:id. It must be installed before the routes. A
+// request whose handler panics is counted as a 500 before the panic goes on
+// to the recovery middleware.
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		defer func() {
+			status := ctx.Writer.Status()
+			if recovered := recover(); recovered != nil {
+				status = http.StatusInternalServerError
+				defer panic(recovered)
+			}
+
+			route := ctx.FullPath()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			method := ctx.Request.Method
+			m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+			m.httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		}()
+		ctx.Next()
+	}
+}
@@ -0,0 +1,184 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/infrastructure/metrics"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+// loanUseCase returns loan, or err, from checkouts and returns and overdue,
+// or err, from CountOverdueUseCase.
+type loanUseCase struct {
+	application.LoanUseCaseInterface
+	loan    *model.Loan
+	overdue int
+	err     error
+}
+
+func (u loanUseCase) CheckoutUseCase(context.Context, uuid.UUID, uuid.UUID, *time.Time) (*model.Loan, error) {
+	return u.loan, u.err
+}
+
+func (u loanUseCase) ReturnUseCase(context.Context, uuid.UUID) (*model.Loan, error) {
+	return u.loan, u.err
+}
+
+func (u loanUseCase) CountOverdueUseCase(context.Context) (int, error) {
+	return u.overdue, u.err
+}
+
+type reservationUseCase struct {
+	application.ReservationUseCaseInterface
+	expired int
+}
+
+func (u reservationUseCase) PlaceHoldUseCase(context.Context, uuid.UUID, uuid.UUID) (*model.Reservation, error) {
+	return &model.Reservation{}, nil
+}
+
+func (u reservationUseCase) ExpireStaleHoldsUseCase(context.Context) (int, error) {
+	return u.expired, nil
+}
+
+func TestGivenRoutesWhenServeThenCountRequestsByRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := metrics.New()
+	r := gin.New()
+	r.Use(m.Middleware())
+	r.GET("/books/:id", func(ctx *gin.Context) { ctx.Status(http.StatusNoContent) })
+
+	for _, path := range []string{"/books/1", "/books/2", "/nowhere"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `library_http_requests_total{method="GET",route="/books/:id",status="204"} 2`)
+	assert.Contains(t, body, `library_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, body, `library_http_request_duration_seconds_count{method="GET",route="/books/:id"} 2`)
+}
+
+func TestGivenPanickingHandlerWhenServeThenCountInternalServerError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := metrics.New()
+	r := gin.New()
+	r.Use(gin.RecoveryWithWriter(io.Discard), m.Middleware())
+	r.GET("/books/:id", func(*gin.Context) { panic("boom") })
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/1", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, scrape(t, m), `library_http_requests_total{method="GET",route="/books/:id",status="500"} 1`)
+}
+
+func TestGivenOverdueReturnWhenReturnUseCaseThenCountReturnAndOverdue(t *testing.T) {
+	m := metrics.New()
+	returnedAt := time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC)
+	loan := &model.Loan{DueDate: time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC), ReturnedAt: &returnedAt}
+	loans := m.LoanUseCase(loanUseCase{loan: loan})
+
+	_, err := loans.CheckoutUseCase(t.Context(), uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	_, err = loans.ReturnUseCase(t.Context(), uuid.New())
+	require.NoError(t, err)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, "library_loans_checked_out_total 1")
+	assert.Contains(t, body, "library_loans_returned_total 1")
+	assert.Contains(t, body, "library_loans_returned_overdue_total 1")
+}
+
+func TestGivenReturnLaterOnTheDueDateWhenReturnUseCaseThenDoNotCountOverdue(t *testing.T) {
+	m := metrics.New()
+	returnedAt := time.Date(2025, 3, 10, 20, 0, 0, 0, time.UTC)
+	loan := &model.Loan{DueDate: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), ReturnedAt: &returnedAt}
+	loans := m.LoanUseCase(loanUseCase{loan: loan})
+
+	_, err := loans.ReturnUseCase(t.Context(), uuid.New())
+
+	require.NoError(t, err)
+	assert.Contains(t, scrape(t, m), "library_loans_returned_overdue_total 0")
+}
+
+func TestGivenOverdueLoansWhenScrapeThenReportThemAsGauge(t *testing.T) {
+	m := metrics.New()
+	require.NoError(t, m.TrackOverdueLoans(loanUseCase{overdue: 3}))
+
+	body := scrape(t, m)
+
+	assert.Contains(t, body, "# TYPE library_loans_overdue gauge")
+	assert.Contains(t, body, "library_loans_overdue 3")
+}
+
+func TestGivenFailingOverdueCountWhenScrapeThenLeaveOnlyTheGaugeOut(t *testing.T) {
+	m := metrics.New()
+	require.NoError(t, m.TrackOverdueLoans(loanUseCase{err: errors.New("connection refused")}))
+
+	body := scrape(t, m)
+
+	assert.NotContains(t, body, "library_loans_overdue ")
+	assert.Contains(t, body, "library_loans_returned_total 0")
+}
+
+func TestGivenFailedCheckoutWhenCheckoutUseCaseThenDoNotCount(t *testing.T) {
+	m := metrics.New()
+	loans := m.LoanUseCase(loanUseCase{err: errors.New("copy not available")})
+
+	_, err := loans.CheckoutUseCase(t.Context(), uuid.New(), uuid.New(), nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, scrape(t, m), "library_loans_checked_out_total 0")
+}
+
+func TestGivenHoldsWhenReservationUseCaseThenCountPlacedAndExpired(t *testing.T) {
+	m := metrics.New()
+	reservations := m.ReservationUseCase(reservationUseCase{expired: 3})
+
+	_, err := reservations.PlaceHoldUseCase(t.Context(), uuid.New(), uuid.New())
+	require.NoError(t, err)
+	_, err = reservations.ExpireStaleHoldsUseCase(t.Context())
+	require.NoError(t, err)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, "library_holds_placed_total 1")
+	assert.Contains(t, body, "library_holds_expired_total 3")
+}
+
+func TestGivenInstrumentedDBWhenQueryThenObserveDurationAndPoolStats(t *testing.T) {
+	db, sqlMock := mockRepo.SetupGormWithSQLMock(t)
+	m := metrics.New()
+	require.NoError(t, m.InstrumentDB(db, "library"))
+	book := builder.NewBookBuilder().Build()
+	sqlMock.ExpectQuery(`SELECT \* FROM "books"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(book.ID, book.Title))
+
+	var found model.Book
+	require.NoError(t, db.First(&found).Error)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `library_db_query_duration_seconds_count{operation="query",table="books"} 1`)
+	assert.Contains(t, body, `go_sql_max_open_connections{db_name="library"}`)
+}
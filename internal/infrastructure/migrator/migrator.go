@@ -0,0 +1,274 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationsTable records the applied versions. It lives in public so that it
+// can be created before the first migration creates the library schema.
+const migrationsTable = "public.schema_migrations"
+
+// lockID serializes migrators running against the same database.
+const lockID = 7_240_318
+
+var (
+	// ErrNotMigrated is returned by Check while the database has no
+	// schema_migrations table.
+	ErrNotMigrated = errors.New("database is not migrated, run migrate up")
+	// ErrPendingMigrations is returned by Check while the database lacks a
+	// migration known to this build.
+	ErrPendingMigrations = errors.New("database has pending migrations, run migrate up")
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one version of the schema with the SQL that applies and
+// reverts it.
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+// Status is a known migration and when it was applied, nil while pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies the migrations of a file system to a database. Each
+// migration runs in its own transaction together with its schema_migrations
+// row, so a failed migration leaves no trace.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New reads the migrations of files, named NNN_name.up.sql and
+// NNN_name.down.sql. Every version needs both files.
+func New(db *gorm.DB, files fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		content, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+		if match[3] == "up" {
+			migration.up = string(content)
+		} else {
+			migration.down = string(content)
+		}
+	}
+
+	m := &Migrator{db: db}
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %03d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		m.migrations = append(m.migrations, *migration)
+	}
+	sort.Slice(m.migrations, func(i, j int) bool { return m.migrations[i].Version < m.migrations[j].Version })
+	return m, nil
+}
+
+// Up applies every pending migration in version order and returns how many
+// were applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	applied := 0
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			continue
+		}
+		err := m.run(ctx, status.Migration, status.up,
+			"INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			status.Version, status.Name)
+		if err != nil {
+			return applied, fmt.Errorf("apply migration %03d_%s: %w", status.Version, status.Name, err)
+		}
+		slog.Info("Migration applied", "version", status.Version, "name", status.Name)
+		applied++
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns
+// how many were reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	reverted := 0
+	for i := len(statuses) - 1; i >= 0 && reverted < steps; i-- {
+		status := statuses[i]
+		if status.AppliedAt == nil {
+			continue
+		}
+		err := m.run(ctx, status.Migration, status.down,
+			"DELETE FROM "+migrationsTable+" WHERE version = ?", status.Version)
+		if err != nil {
+			return reverted, fmt.Errorf("revert migration %03d_%s: %w", status.Version, status.Name, err)
+		}
+		slog.Info("Migration reverted", "version", status.Version, "name", status.Name)
+		reverted++
+	}
+	return reverted, nil
+}
+
+// Baseline records every pending migration up to version as applied without
+// running it, for databases whose schema was built before migrations were
+// tracked, and returns how many were recorded. version must be a known
+// migration.
+func (m *Migrator) Baseline(ctx context.Context, version int) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !slices.ContainsFunc(statuses, func(status Status) bool { return status.Version == version }) {
+		return 0, fmt.Errorf("baseline: %d is not a known migration version", version)
+	}
+	recorded := 0
+	err = m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockID).Error; err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if status.Version > version || status.AppliedAt != nil {
+				continue
+			}
+			result := tx.Exec("INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				status.Version, status.Name)
+			if result.Error != nil {
+				return fmt.Errorf("baseline migration %03d_%s: %w", status.Version, status.Name, result.Error)
+			}
+			recorded += int(result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("Migrations baselined", "version", version, "recorded", recorded)
+	return recorded, nil
+}
+
+// Status lists every known migration in version order with the time it was
+// applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	db := m.db.WithContext(ctx)
+	err := db.Exec("CREATE TABLE IF NOT EXISTS " + migrationsTable + ` (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`).Error
+	if err != nil {
+		return nil, fmt.Errorf("create %s: %w", migrationsTable, err)
+	}
+	return m.statuses(db)
+}
+
+// Check fails with ErrNotMigrated or ErrPendingMigrations unless every known
+// migration has been applied. Unlike Status it only reads, so it can back the
+// readiness probe.
+func (m *Migrator) Check(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	var table *string
+	if err := db.Raw("SELECT to_regclass(?)::text", migrationsTable).Scan(&table).Error; err != nil {
+		return fmt.Errorf("look up %s: %w", migrationsTable, err)
+	}
+	if table == nil {
+		return ErrNotMigrated
+	}
+
+	statuses, err := m.statuses(db)
+	if err != nil {
+		return err
+	}
+	pending := 0
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d of %d not applied", ErrPendingMigrations, pending, len(statuses))
+	}
+	return nil
+}
+
+// statuses matches the known migrations with the rows of schema_migrations.
+func (m *Migrator) statuses(db *gorm.DB) ([]Status, error) {
+	var rows []struct {
+		Version   int
+		AppliedAt time.Time
+	}
+	if err := db.Raw("SELECT version, applied_at FROM " + migrationsTable).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("read %s: %w", migrationsTable, err)
+	}
+	appliedAt := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		appliedAt[row.Version] = row.AppliedAt
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i].Migration = migration
+		if at, ok := appliedAt[migration.Version]; ok {
+			statuses[i].AppliedAt = &at
+		}
+	}
+	return statuses, nil
+}
+
+// run executes script and the bookkeeping statement record in one
+// transaction. It holds a lock for the transaction and skips the script when
+// record changes no row, which means another migrator got there first.
+func (m *Migrator) run(ctx context.Context, migration Migration, script, record string, args ...any) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockID).Error; err != nil {
+			return err
+		}
+		result := tx.Exec(record, args...)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			slog.Info("Migration already handled by another migrator", "version", migration.Version)
+			return nil
+		}
+		return tx.Exec(script).Error
+	})
+}
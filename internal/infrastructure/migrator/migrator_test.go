@@ -0,0 +1,228 @@
+package migrator_test
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"library/internal/infrastructure/migrator"
+	"library/internal/test/mock"
+	"library/migration"
+)
+
+const (
+	createTableRegex   = `^CREATE TABLE IF NOT EXISTS public\.schema_migrations`
+	selectAppliedRegex = `^SELECT version, applied_at FROM public\.schema_migrations$`
+	lookupTableRegex   = `^SELECT to_regclass\(\$1\)::text$`
+	lockRegex          = `^SELECT pg_advisory_xact_lock\(\$1\)$`
+)
+
+var files = fstest.MapFS{
+	"001_init.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+	"001_init.down.sql": {Data: []byte("DROP TABLE a;")},
+	"002_more.up.sql":   {Data: []byte("CREATE TABLE b (id INT);")},
+	"002_more.down.sql": {Data: []byte("DROP TABLE b;")},
+	"README.md":         {Data: []byte("not a migration")},
+}
+
+func expectApplied(sqlMock sqlmock.Sqlmock, versions ...int) {
+	sqlMock.ExpectExec(createTableRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, version := range versions {
+		rows.AddRow(version, time.Now())
+	}
+	sqlMock.ExpectQuery(selectAppliedRegex).WillReturnRows(rows)
+}
+
+func TestGivenEmbeddedMigrationsWhenNewThenEveryVersionHasUpAndDown(t *testing.T) {
+	gdb, _ := mock.SetupGormWithSQLMock(t)
+
+	_, err := migrator.New(gdb, migration.Files)
+
+	assert.NoError(t, err)
+}
+
+func TestGivenMigrationWithoutDownWhenNewThenReturnsError(t *testing.T) {
+	gdb, _ := mock.SetupGormWithSQLMock(t)
+
+	_, err := migrator.New(gdb, fstest.MapFS{"001_init.up.sql": {Data: []byte("SELECT 1;")}})
+
+	assert.ErrorContains(t, err, "001_init needs both an up and a down file")
+}
+
+func TestGivenOneAppliedWhenUpThenAppliesOnlyPendingInTransaction(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock, 1)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(lockRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`^INSERT INTO public\.schema_migrations \(version, name\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING$`).
+		WithArgs(2, "more").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`^CREATE TABLE b \(id INT\);$`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	applied, err := m.Up(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenFailingScriptWhenUpThenRollsBackAndStops(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(lockRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`^INSERT INTO public\.schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`^CREATE TABLE a`).WillReturnError(errors.New("syntax error"))
+	sqlMock.ExpectRollback()
+
+	applied, err := m.Up(t.Context())
+
+	assert.ErrorContains(t, err, "apply migration 001_init")
+	assert.Zero(t, applied)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenMigrationAppliedConcurrentlyWhenUpThenSkipsScript(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock, 1)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(lockRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`^INSERT INTO public\.schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	_, err = m.Up(t.Context())
+
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenTwoAppliedWhenDownThenRevertsNewestFirst(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock, 1, 2)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(lockRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`^DELETE FROM public\.schema_migrations WHERE version = \$1$`).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`^DROP TABLE b;$`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	reverted, err := m.Down(t.Context(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reverted)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenPendingMigrationWhenStatusThenReportsItWithoutAppliedAt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock, 1)
+
+	statuses, err := m.Status(t.Context())
+
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "init", statuses[0].Name)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Equal(t, 2, statuses[1].Version)
+	assert.Nil(t, statuses[1].AppliedAt)
+}
+
+// expectChecked expects Check to find schema_migrations with versions and to
+// run nothing else.
+func expectChecked(sqlMock sqlmock.Sqlmock, versions ...int) {
+	sqlMock.ExpectQuery(lookupTableRegex).
+		WithArgs("public.schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"to_regclass"}).AddRow("schema_migrations"))
+	rows := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, version := range versions {
+		rows.AddRow(version, time.Now())
+	}
+	sqlMock.ExpectQuery(selectAppliedRegex).WillReturnRows(rows)
+}
+
+func TestGivenPendingMigrationWhenCheckThenReturnsPendingMigrations(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectChecked(sqlMock, 1)
+
+	err = m.Check(t.Context())
+
+	assert.ErrorIs(t, err, migrator.ErrPendingMigrations)
+	assert.ErrorContains(t, err, "1 of 2 not applied")
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenEveryMigrationAppliedWhenCheckThenReturnsNil(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectChecked(sqlMock, 1, 2)
+
+	assert.NoError(t, m.Check(t.Context()))
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenNoMigrationsTableWhenCheckThenReturnsNotMigratedWithoutCreatingIt(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	sqlMock.ExpectQuery(lookupTableRegex).
+		WithArgs("public.schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"to_regclass"}).AddRow(nil))
+
+	err = m.Check(t.Context())
+
+	assert.ErrorIs(t, err, migrator.ErrNotMigrated)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenSchemaBuiltBeforeMigrationsWhenBaselineThenRecordsVersionsWithoutRunningThem(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(lockRegex).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`^INSERT INTO public\.schema_migrations \(version, name\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING$`).
+		WithArgs(1, "init").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	recorded, err := m.Baseline(t.Context(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, recorded)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGivenUnknownVersionWhenBaselineThenRecordsNothing(t *testing.T) {
+	gdb, sqlMock := mock.SetupGormWithSQLMock(t)
+	m, err := migrator.New(gdb, files)
+	require.NoError(t, err)
+	expectApplied(sqlMock)
+
+	recorded, err := m.Baseline(t.Context(), 9)
+
+	assert.ErrorContains(t, err, "9 is not a known migration version")
+	assert.Zero(t, recorded)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
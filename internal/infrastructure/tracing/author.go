@@ -0,0 +1,165 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+	"library/internal/domain/service"
+)
+
+// AuthorUseCase opens a span around every call to next.
+func AuthorUseCase(next application.AuthorUseCaseInterface) application.AuthorUseCaseInterface {
+	return &authorUseCase{next}
+}
+
+// AuthorService opens a span around every call to next.
+func AuthorService(next service.AuthorServiceInterface) service.AuthorServiceInterface {
+	return &authorService{next}
+}
+
+// AuthorPort opens a span around every call to next.
+func AuthorPort(next port.AuthorPort) port.AuthorPort {
+	return &authorPort{next}
+}
+
+type authorUseCase struct {
+	next application.AuthorUseCaseInterface
+}
+
+func (u *authorUseCase) CreateAuthorUseCase(ctx context.Context, author *model.Author) (err error) {
+	ctx, span := start(ctx, "AuthorUseCase.CreateAuthor")
+	defer func() { end(span, err) }()
+	return u.next.CreateAuthorUseCase(ctx, author)
+}
+
+func (u *authorUseCase) GetAuthorsUseCase(ctx context.Context, query port.ListQuery) (_ *port.Page[model.Author], err error) {
+	ctx, span := start(ctx, "AuthorUseCase.GetAuthors")
+	defer func() { end(span, err) }()
+	return u.next.GetAuthorsUseCase(ctx, query)
+}
+
+func (u *authorUseCase) GetAuthorUseCase(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorUseCase.GetAuthor")
+	defer func() { end(span, err) }()
+	return u.next.GetAuthorUseCase(ctx, id)
+}
+
+func (u *authorUseCase) UpdateAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.Author) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorUseCase.UpdateAuthor")
+	defer func() { end(span, err) }()
+	return u.next.UpdateAuthorUseCase(ctx, id, patch)
+}
+
+func (u *authorUseCase) PatchAuthorUseCase(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorUseCase.PatchAuthor")
+	defer func() { end(span, err) }()
+	return u.next.PatchAuthorUseCase(ctx, id, patch)
+}
+
+func (u *authorUseCase) DeleteAuthorUseCase(ctx context.Context, id uuid.UUID, force bool) (err error) {
+	ctx, span := start(ctx, "AuthorUseCase.DeleteAuthor")
+	defer func() { end(span, err) }()
+	return u.next.DeleteAuthorUseCase(ctx, id, force)
+}
+
+func (u *authorUseCase) RestoreAuthorUseCase(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorUseCase.RestoreAuthor")
+	defer func() { end(span, err) }()
+	return u.next.RestoreAuthorUseCase(ctx, id)
+}
+
+type authorService struct {
+	next service.AuthorServiceInterface
+}
+
+func (s *authorService) CreateAuthor(ctx context.Context, author *model.Author) (err error) {
+	ctx, span := start(ctx, "AuthorService.CreateAuthor")
+	defer func() { end(span, err) }()
+	return s.next.CreateAuthor(ctx, author)
+}
+
+func (s *authorService) GetAuthors(ctx context.Context, query port.ListQuery) (_ *port.Page[model.Author], err error) {
+	ctx, span := start(ctx, "AuthorService.GetAuthors")
+	defer func() { end(span, err) }()
+	return s.next.GetAuthors(ctx, query)
+}
+
+func (s *authorService) GetAuthor(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorService.GetAuthor")
+	defer func() { end(span, err) }()
+	return s.next.GetAuthor(ctx, id)
+}
+
+func (s *authorService) UpdateAuthor(ctx context.Context, id uuid.UUID, patch *model.Author) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorService.UpdateAuthor")
+	defer func() { end(span, err) }()
+	return s.next.UpdateAuthor(ctx, id, patch)
+}
+
+func (s *authorService) PatchAuthor(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorService.PatchAuthor")
+	defer func() { end(span, err) }()
+	return s.next.PatchAuthor(ctx, id, patch)
+}
+
+func (s *authorService) DeleteAuthor(ctx context.Context, id uuid.UUID, force bool) (err error) {
+	ctx, span := start(ctx, "AuthorService.DeleteAuthor")
+	defer func() { end(span, err) }()
+	return s.next.DeleteAuthor(ctx, id, force)
+}
+
+func (s *authorService) RestoreAuthor(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorService.RestoreAuthor")
+	defer func() { end(span, err) }()
+	return s.next.RestoreAuthor(ctx, id)
+}
+
+type authorPort struct {
+	next port.AuthorPort
+}
+
+func (p *authorPort) Save(ctx context.Context, author *model.Author) (err error) {
+	ctx, span := start(ctx, "AuthorRepository.Save")
+	defer func() { end(span, err) }()
+	return p.next.Save(ctx, author)
+}
+
+func (p *authorPort) FindAll(ctx context.Context, query port.ListQuery) (_ *port.Page[model.Author], err error) {
+	ctx, span := start(ctx, "AuthorRepository.FindAll")
+	defer func() { end(span, err) }()
+	return p.next.FindAll(ctx, query)
+}
+
+func (p *authorPort) FindById(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorRepository.FindById")
+	defer func() { end(span, err) }()
+	return p.next.FindById(ctx, id)
+}
+
+func (p *authorPort) Update(ctx context.Context, id uuid.UUID, patch *model.Author) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorRepository.Update")
+	defer func() { end(span, err) }()
+	return p.next.Update(ctx, id, patch)
+}
+
+func (p *authorPort) Patch(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorRepository.Patch")
+	defer func() { end(span, err) }()
+	return p.next.Patch(ctx, id, patch)
+}
+
+func (p *authorPort) Delete(ctx context.Context, id uuid.UUID, force bool) (err error) {
+	ctx, span := start(ctx, "AuthorRepository.Delete")
+	defer func() { end(span, err) }()
+	return p.next.Delete(ctx, id, force)
+}
+
+func (p *authorPort) Restore(ctx context.Context, id uuid.UUID) (_ *model.Author, err error) {
+	ctx, span := start(ctx, "AuthorRepository.Restore")
+	defer func() { end(span, err) }()
+	return p.next.Restore(ctx, id)
+}
@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+var (
+	stringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numericLiteral = regexp.MustCompile(`([^\w$.]|^)-?\d+(?:\.\d+)?\b`)
+)
+
+// InstrumentDB opens a client span for every statement run through db. The
+// statement is recorded without its arguments or literals.
+func InstrumentDB(db *gorm.DB) error {
+	cb := db.Callback()
+	err := errors.Join(
+		cb.Create().Before("gorm:create").Register("tracing:before_create", startQuery("INSERT")),
+		cb.Create().After("gorm:create").Register("tracing:after_create", endQuery),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", startQuery("SELECT")),
+		cb.Query().After("gorm:query").Register("tracing:after_query", endQuery),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", startQuery("UPDATE")),
+		cb.Update().After("gorm:update").Register("tracing:after_update", endQuery),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", startQuery("DELETE")),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", endQuery),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", startQuery("ROW")),
+		cb.Row().After("gorm:row").Register("tracing:after_row", endQuery),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", startQuery("RAW")),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", endQuery),
+	)
+	if err != nil {
+		return fmt.Errorf("trace database: %w", err)
+	}
+	return nil
+}
+
+func startQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		name := operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		ctx, span := tracer().Start(db.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system.name", "postgresql"),
+				attribute.String("db.operation.name", operation),
+				attribute.String("db.collection.name", db.Statement.Table),
+			))
+		db.Statement.Context = ctx
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func endQuery(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	span.SetAttributes(
+		attribute.String("db.query.text", SanitizeSQL(db.Statement.SQL.String())),
+		attribute.Int64("db.response.rows_affected", db.RowsAffected),
+	)
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	end(span, err)
+}
+
+// SanitizeSQL replaces the string and numeric literals of a statement with ?
+// and collapses its whitespace, so that no value written inline ends up in a
+// trace. Placeholders such as $1 are kept.
+func SanitizeSQL(sql string) string {
+	sql = stringLiteral.ReplaceAllString(sql, "?")
+	sql = numericLiteral.ReplaceAllString(sql, "${1}?")
+	return strings.Join(strings.Fields(sql), " ")
+}
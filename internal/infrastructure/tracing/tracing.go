@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters a trace can be sent to. With ExporterNone spans are not recorded
+// but trace context is still propagated.
+const (
+	ExporterNone   = "none"
+	ExporterStderr = "stderr"
+	ExporterOTLP   = "otlp"
+)
+
+// flushTimeout bounds the export of the last spans on Close.
+const flushTimeout = 5 * time.Second
+
+var Exporters = []string{ExporterNone, ExporterStderr, ExporterOTLP}
+
+// instrumentationName names the tracer of the spans opened by this package.
+const instrumentationName = "library"
+
+// Options selects where spans go and how many of them are kept.
+type Options struct {
+	Exporter     string
+	OTLPEndpoint string
+	ServiceName  string
+	SampleRatio  float64
+}
+
+// Provider exports the spans of the service until it is closed.
+type Provider struct {
+	provider *sdktrace.TracerProvider
+}
+
+// Setup installs the W3C trace context and baggage propagators and, unless
+// the exporter is ExporterNone, a tracer provider exporting to it. Requests
+// carrying a sampled traceparent are always traced; other traces are kept
+// with the sample ratio.
+func Setup(ctx context.Context, opts Options) (*Provider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case ExporterNone:
+		return &Provider{}, nil
+	case ExporterStderr:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.OTLPEndpoint))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s trace exporter: %w", opts.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", opts.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return &Provider{provider: provider}, nil
+}
+
+// Close exports the spans still buffered and stops the provider.
+func (p *Provider) Close() error {
+	if p.provider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	return p.provider.Shutdown(ctx)
+}
+
+// tracer is looked up on every span so that it follows the provider installed
+// by Setup.
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// start opens an internal span named name as a child of the span in ctx.
+func start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name)
+}
+
+// end records err, if any, on span and ends it.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
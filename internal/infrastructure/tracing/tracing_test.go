@@ -0,0 +1,138 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/tracing"
+	"library/internal/test/builder"
+	mockRepo "library/internal/test/mock"
+)
+
+// recordSpans installs a tracer provider that keeps every span for the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanNamed(t *testing.T, spans []sdktrace.ReadOnlySpan, name string) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if span.Name() == name {
+			return span
+		}
+	}
+	require.Failf(t, "span not found", "no span named %q", name)
+	return nil
+}
+
+func attributeOf(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestGivenTracedAuthorLayersWhenGetAuthorThenNestOneSpanPerLayer(t *testing.T) {
+	recorder := recordSpans(t)
+	repo := new(mockRepo.AuthorRepoMock)
+	author := builder.NewAuthorBuilder().Build()
+	repo.On("FindById", author.ID).Return(author, nil)
+	svc := tracing.AuthorService(service.NewAuthorService(tracing.AuthorPort(repo)))
+	useCase := tracing.AuthorUseCase(application.NewAuthorUseCase(svc))
+
+	_, err := useCase.GetAuthorUseCase(t.Context(), author.ID)
+
+	require.NoError(t, err)
+	spans := recorder.Ended()
+	useCaseSpan := spanNamed(t, spans, "AuthorUseCase.GetAuthor")
+	serviceSpan := spanNamed(t, spans, "AuthorService.GetAuthor")
+	repoSpan := spanNamed(t, spans, "AuthorRepository.FindById")
+	assert.Equal(t, useCaseSpan.SpanContext().SpanID(), serviceSpan.Parent().SpanID())
+	assert.Equal(t, serviceSpan.SpanContext().SpanID(), repoSpan.Parent().SpanID())
+	assert.Equal(t, useCaseSpan.SpanContext().TraceID(), repoSpan.SpanContext().TraceID())
+}
+
+func TestGivenMissingAuthorWhenGetAuthorThenMarkSpansAsFailed(t *testing.T) {
+	recorder := recordSpans(t)
+	repo := new(mockRepo.AuthorRepoMock)
+	id := uuid.New()
+	repo.On("FindById", id).Return((*model.Author)(nil), model.ErrAuthorNotFound)
+	useCase := tracing.AuthorUseCase(application.NewAuthorUseCase(service.NewAuthorService(tracing.AuthorPort(repo))))
+
+	_, err := useCase.GetAuthorUseCase(t.Context(), id)
+
+	assert.ErrorIs(t, err, model.ErrAuthorNotFound)
+	for _, span := range recorder.Ended() {
+		assert.Equal(t, codes.Error, span.Status().Code, span.Name())
+	}
+}
+
+func TestGivenTracedDBWhenQueryThenRecordSanitizedStatementUnderParent(t *testing.T) {
+	recorder := recordSpans(t)
+	db, sqlMock := mockRepo.SetupGormWithSQLMock(t)
+	require.NoError(t, tracing.InstrumentDB(db))
+	sqlMock.ExpectQuery(`SELECT \* FROM "books" WHERE title = \$1`).
+		WithArgs("Dune").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(uuid.New(), "Dune"))
+	ctx, parent := otel.Tracer("test").Start(t.Context(), "parent")
+
+	var books []model.Book
+	require.NoError(t, db.WithContext(ctx).Where("title = ?", "Dune").Find(&books).Error)
+	parent.End()
+
+	span := spanNamed(t, recorder.Ended(), "SELECT books")
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+	assert.Equal(t, "books", attributeOf(span, "db.collection.name"))
+	assert.Contains(t, attributeOf(span, "db.query.text"), "WHERE title = $1")
+	assert.NotContains(t, attributeOf(span, "db.query.text"), "Dune")
+}
+
+func TestGivenInlineLiteralsWhenSanitizeSQLThenReplaceThem(t *testing.T) {
+	sql := "SELECT *\n\tFROM books WHERE title = 'Don''t' AND published_year > 1999 AND id = $1 LIMIT 10"
+
+	assert.Equal(t, "SELECT * FROM books WHERE title = ? AND published_year > ? AND id = $1 LIMIT ?",
+		tracing.SanitizeSQL(sql))
+}
+
+func TestGivenTraceparentWhenServeThenContinueTheCallersTrace(t *testing.T) {
+	recordSpans(t)
+	_, err := tracing.Setup(t.Context(), tracing.Options{Exporter: tracing.ExporterNone})
+	require.NoError(t, err)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(otelgin.Middleware("library-api"))
+	var traceID trace.TraceID
+	r.GET("/authors", func(ctx *gin.Context) {
+		traceID = trace.SpanContextFromContext(ctx.Request.Context()).TraceID()
+	})
+	req := httptest.NewRequest(http.MethodGet, "/authors", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID.String())
+}
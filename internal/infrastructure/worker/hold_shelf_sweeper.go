@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"library/internal/application"
+)
+
+// HoldShelfSweeper periodically expires holds that were not picked up in time
+// so their copies move on to the next member in the queue.
+type HoldShelfSweeper struct {
+	app      application.ReservationUseCaseInterface
+	interval time.Duration
+}
+
+func NewHoldShelfSweeper(app application.ReservationUseCaseInterface, interval time.Duration) *HoldShelfSweeper {
+	return &HoldShelfSweeper{app: app, interval: interval}
+}
+
+// Run sweeps once per interval until ctx is canceled. A sweep under way when
+// ctx is canceled runs to the end so its transaction is not cut off.
+func (w *HoldShelfSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	slog.Info("Hold shelf sweeper started", "interval", w.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Hold shelf sweeper stopped")
+			return
+		case <-ticker.C:
+			w.Sweep(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+// Sweep expires stale holds once. Errors are logged and retried on the next tick.
+func (w *HoldShelfSweeper) Sweep(ctx context.Context) {
+	expired, err := w.app.ExpireStaleHoldsUseCase(ctx)
+	if err != nil {
+		slog.Error("Error trying to expire stale holds", "error", err)
+		return
+	}
+	if expired > 0 {
+		slog.Info("Expired stale holds", "count", expired)
+	}
+}
@@ -0,0 +1,76 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/application"
+	"library/internal/domain/model"
+	"library/internal/domain/service"
+	"library/internal/infrastructure/worker"
+	mockRepo "library/internal/test/mock"
+)
+
+func newHoldShelfSweeper(reservations *mockRepo.ReservationRepoMock, interval time.Duration) *worker.HoldShelfSweeper {
+	svc := service.NewReservationService(mockRepo.TransactionMock{}, reservations, new(mockRepo.BookRepoMock),
+		new(mockRepo.MemberRepoMock), new(mockRepo.BookCopyRepoMock), service.DefaultLoanPolicy())
+	return worker.NewHoldShelfSweeper(application.NewReservationUseCase(svc), interval)
+}
+
+func TestGivenNoStaleHoldsWhenSweepThenQueryExpiredHolds(t *testing.T) {
+	reservations := new(mockRepo.ReservationRepoMock)
+	reservations.On("FindExpiredHolds", mock.AnythingOfType("time.Time"), mock.AnythingOfType("int")).
+		Return([]model.Reservation{}, nil)
+	sweeper := newHoldShelfSweeper(reservations, time.Minute)
+
+	sweeper.Sweep(t.Context())
+
+	reservations.AssertExpectations(t)
+}
+
+func TestGivenDatabaseErrorWhenSweepThenDoNotPanic(t *testing.T) {
+	reservations := new(mockRepo.ReservationRepoMock)
+	reservations.On("FindExpiredHolds", mock.AnythingOfType("time.Time"), mock.AnythingOfType("int")).
+		Return([]model.Reservation{}, errors.New("db down"))
+	sweeper := newHoldShelfSweeper(reservations, time.Minute)
+
+	assert.NotPanics(t, func() { sweeper.Sweep(t.Context()) })
+}
+
+func TestGivenRunningSweeperWhenContextCanceledThenStop(t *testing.T) {
+	swept := make(chan struct{}, 1)
+	reservations := new(mockRepo.ReservationRepoMock)
+	reservations.On("FindExpiredHolds", mock.AnythingOfType("time.Time"), mock.AnythingOfType("int")).
+		Run(func(mock.Arguments) {
+			select {
+			case swept <- struct{}{}:
+			default:
+			}
+		}).
+		Return([]model.Reservation{}, nil)
+	sweeper := newHoldShelfSweeper(reservations, time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+
+	go func() {
+		sweeper.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-swept:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not sweep")
+	}
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not stop after cancel")
+	}
+}
@@ -41,6 +41,16 @@ func UpdateAuthorBuilder() *AuthorBuilder {
 	}
 }
 
+func (b *AuthorBuilder) WithID(id uuid.UUID) *AuthorBuilder {
+	b.id = id
+	return b
+}
+
+func (b *AuthorBuilder) WithBio(bio string) *AuthorBuilder {
+	b.bio = bio
+	return b
+}
+
 func (b *AuthorBuilder) Build() *model.Author {
 	return &model.Author{
 		ID:        b.id,
@@ -9,15 +9,29 @@ import (
 type BookAuthorBuilder struct {
 	bookID   uuid.UUID
 	authorID uuid.UUID
+	role     string
+	position int
 }
 
 func NewBookAuthorBuilder() *BookAuthorBuilder {
-	return &BookAuthorBuilder{bookID: uuid.New(), authorID: uuid.New()}
+	return &BookAuthorBuilder{
+		bookID:   uuid.New(),
+		authorID: uuid.New(),
+		role:     model.BookAuthorRoleAuthor,
+		position: 1,
+	}
 }
 
+func (b *BookAuthorBuilder) WithBookID(id uuid.UUID) *BookAuthorBuilder   { b.bookID = id; return b }
+func (b *BookAuthorBuilder) WithAuthorID(id uuid.UUID) *BookAuthorBuilder { b.authorID = id; return b }
+func (b *BookAuthorBuilder) WithRole(r string) *BookAuthorBuilder         { b.role = r; return b }
+func (b *BookAuthorBuilder) WithPosition(p int) *BookAuthorBuilder        { b.position = p; return b }
+
 func (b *BookAuthorBuilder) Build() *model.BookAuthor {
 	return &model.BookAuthor{
 		BookID:   b.bookID,
 		AuthorID: b.authorID,
+		Role:     b.role,
+		Position: b.position,
 	}
 }
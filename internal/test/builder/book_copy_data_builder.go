@@ -14,6 +14,7 @@ type BookCopyBuilder struct {
 	barcode   string
 	condition string
 	isActive  bool
+	available bool
 	createdAt *time.Time
 }
 
@@ -25,10 +26,17 @@ func NewBookCopyBuilder() *BookCopyBuilder {
 		barcode:   uuid.NewString(),
 		condition: "NEW",
 		isActive:  true,
+		available: true,
 		createdAt: &date,
 	}
 }
 
+func (b *BookCopyBuilder) WithID(id uuid.UUID) *BookCopyBuilder     { b.id = id; return b }
+func (b *BookCopyBuilder) WithBookID(id uuid.UUID) *BookCopyBuilder { b.bookID = id; return b }
+func (b *BookCopyBuilder) WithBarcode(s string) *BookCopyBuilder    { b.barcode = s; return b }
+func (b *BookCopyBuilder) WithActive(a bool) *BookCopyBuilder       { b.isActive = a; return b }
+func (b *BookCopyBuilder) WithAvailable(a bool) *BookCopyBuilder    { b.available = a; return b }
+
 func (b *BookCopyBuilder) Build() *model.BookCopy {
 	return &model.BookCopy{
 		ID:        b.id,
@@ -36,6 +44,7 @@ func (b *BookCopyBuilder) Build() *model.BookCopy {
 		Barcode:   b.barcode,
 		Condition: b.condition,
 		IsActive:  b.isActive,
+		Available: b.available,
 		CreatedAt: *b.createdAt,
 	}
 }
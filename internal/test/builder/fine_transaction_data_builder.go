@@ -0,0 +1,64 @@
+package builder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"library/internal/domain/model"
+)
+
+type FineTransactionBuilder struct {
+	id          uuid.UUID
+	loanID      uuid.UUID
+	memberID    uuid.UUID
+	kind        string
+	amountCents int
+	note        string
+	createdAt   *time.Time
+}
+
+func NewFineTransactionBuilder() *FineTransactionBuilder {
+	date := time.Now()
+	return &FineTransactionBuilder{
+		id:          uuid.New(),
+		loanID:      uuid.New(),
+		memberID:    uuid.New(),
+		kind:        model.FineTransactionPayment,
+		amountCents: 100,
+		note:        "Paid at the front desk",
+		createdAt:   &date,
+	}
+}
+
+func (b *FineTransactionBuilder) WithLoanID(id uuid.UUID) *FineTransactionBuilder {
+	b.loanID = id
+	return b
+}
+
+func (b *FineTransactionBuilder) WithMemberID(id uuid.UUID) *FineTransactionBuilder {
+	b.memberID = id
+	return b
+}
+
+func (b *FineTransactionBuilder) WithKind(kind string) *FineTransactionBuilder {
+	b.kind = kind
+	return b
+}
+
+func (b *FineTransactionBuilder) WithAmountCents(cents int) *FineTransactionBuilder {
+	b.amountCents = cents
+	return b
+}
+
+func (b *FineTransactionBuilder) Build() *model.FineTransaction {
+	return &model.FineTransaction{
+		ID:          b.id,
+		LoanID:      b.loanID,
+		MemberID:    b.memberID,
+		Kind:        b.kind,
+		AmountCents: b.amountCents,
+		Note:        b.note,
+		CreatedAt:   *b.createdAt,
+	}
+}
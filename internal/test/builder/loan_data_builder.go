@@ -9,14 +9,15 @@ import (
 )
 
 type LoanBuilder struct {
-	id         uuid.UUID
-	memberID   uuid.UUID
-	copyID     uuid.UUID
-	loanedAt   *time.Time
-	dueDate    *time.Time
-	returnedAt *time.Time
-	fineCents  int
-	createdAt  *time.Time
+	id           uuid.UUID
+	memberID     uuid.UUID
+	copyID       uuid.UUID
+	loanedAt     *time.Time
+	dueDate      *time.Time
+	returnedAt   *time.Time
+	fineCents    int
+	renewalCount int
+	createdAt    *time.Time
 }
 
 func NewLoanBuilder() *LoanBuilder {
@@ -34,15 +35,25 @@ func NewLoanBuilder() *LoanBuilder {
 	}
 }
 
+func (b *LoanBuilder) WithID(id uuid.UUID) *LoanBuilder        { b.id = id; return b }
+func (b *LoanBuilder) WithMemberID(id uuid.UUID) *LoanBuilder  { b.memberID = id; return b }
+func (b *LoanBuilder) WithCopyID(id uuid.UUID) *LoanBuilder    { b.copyID = id; return b }
+func (b *LoanBuilder) WithLoanedAt(t time.Time) *LoanBuilder   { b.loanedAt = &t; return b }
+func (b *LoanBuilder) WithDueDate(t time.Time) *LoanBuilder    { b.dueDate = &t; return b }
+func (b *LoanBuilder) WithReturnedAt(t time.Time) *LoanBuilder { b.returnedAt = &t; return b }
+func (b *LoanBuilder) WithFineCents(cents int) *LoanBuilder    { b.fineCents = cents; return b }
+func (b *LoanBuilder) WithRenewalCount(n int) *LoanBuilder     { b.renewalCount = n; return b }
+
 func (b *LoanBuilder) Build() *model.Loan {
 	return &model.Loan{
-		ID:         b.id,
-		MemberID:   b.memberID,
-		CopyID:     b.copyID,
-		LoanedAt:   *b.loanedAt,
-		DueDate:    *b.dueDate,
-		ReturnedAt: b.returnedAt,
-		FineCents:  b.fineCents,
-		CreatedAt:  *b.createdAt,
+		ID:           b.id,
+		MemberID:     b.memberID,
+		CopyID:       b.copyID,
+		LoanedAt:     *b.loanedAt,
+		DueDate:      *b.dueDate,
+		ReturnedAt:   b.returnedAt,
+		FineCents:    b.fineCents,
+		RenewalCount: b.renewalCount,
+		CreatedAt:    *b.createdAt,
 	}
 }
@@ -9,36 +9,67 @@ import (
 )
 
 type MemberBuilder struct {
-	id        uuid.UUID
-	fullName  string
-	email     string
-	phone     string
-	isActive  bool
-	createdAt *time.Time
-	updatedAt *time.Time
+	id         uuid.UUID
+	fullName   string
+	email      string
+	phone      string
+	category   string
+	membership *model.MembershipCategory
+	isActive   bool
+	createdAt  *time.Time
+	updatedAt  *time.Time
 }
 
 func NewMemberBuilder() *MemberBuilder {
 	date := time.Now()
 	return &MemberBuilder{
-		id:        uuid.New(),
-		fullName:  "John Doe",
-		email:     "john.doe@example.com",
-		phone:     "+1-555-1234",
-		isActive:  true,
-		createdAt: &date,
-		updatedAt: &date,
+		id:         uuid.New(),
+		fullName:   "John Doe",
+		email:      "john.doe@example.com",
+		phone:      "+1-555-1234",
+		category:   model.DefaultMemberCategory,
+		membership: standardCategory(),
+		isActive:   true,
+		createdAt:  &date,
+		updatedAt:  &date,
 	}
 }
 
+// standardCategory has the terms of the standard category seeded by the
+// migrations, the one members are read with by default.
+func standardCategory() *model.MembershipCategory {
+	return NewMembershipCategoryBuilder().
+		WithName(model.DefaultMemberCategory).
+		WithMaxLoans(5).
+		WithLoanPeriodDays(14).
+		WithRenewalLimit(2).
+		WithFine(25, 0, 0).
+		Build()
+}
+
+func (b *MemberBuilder) WithID(id uuid.UUID) *MemberBuilder   { b.id = id; return b }
+func (b *MemberBuilder) WithName(n string) *MemberBuilder     { b.fullName = n; return b }
+func (b *MemberBuilder) WithEmail(e string) *MemberBuilder    { b.email = e; return b }
+func (b *MemberBuilder) WithCategory(c string) *MemberBuilder { b.category = c; return b }
+func (b *MemberBuilder) WithActive(a bool) *MemberBuilder     { b.isActive = a; return b }
+
+// WithMembershipCategory sets both the category name and the loaded category.
+func (b *MemberBuilder) WithMembershipCategory(c *model.MembershipCategory) *MemberBuilder {
+	b.category = c.Name
+	b.membership = c
+	return b
+}
+
 func (b *MemberBuilder) Build() *model.Member {
 	return &model.Member{
-		ID:        b.id,
-		FullName:  b.fullName,
-		Email:     b.email,
-		Phone:     b.phone,
-		IsActive:  b.isActive,
-		CreatedAt: *b.createdAt,
-		UpdatedAt: *b.updatedAt,
+		ID:                 b.id,
+		FullName:           b.fullName,
+		Email:              b.email,
+		Phone:              b.phone,
+		Category:           b.category,
+		MembershipCategory: b.membership,
+		IsActive:           b.isActive,
+		CreatedAt:          *b.createdAt,
+		UpdatedAt:          *b.updatedAt,
 	}
 }
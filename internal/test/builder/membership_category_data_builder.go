@@ -0,0 +1,71 @@
+package builder
+
+import (
+	"time"
+
+	"library/internal/domain/model"
+)
+
+type MembershipCategoryBuilder struct {
+	name               string
+	maxLoans           int
+	loanPeriodDays     int
+	renewalLimit       int
+	fineDailyRateCents int
+	fineGraceDays      int
+	fineMaxCents       int
+	createdAt          *time.Time
+}
+
+func NewMembershipCategoryBuilder() *MembershipCategoryBuilder {
+	date := time.Now()
+	return &MembershipCategoryBuilder{
+		name:               "student",
+		maxLoans:           3,
+		loanPeriodDays:     21,
+		renewalLimit:       1,
+		fineDailyRateCents: 10,
+		createdAt:          &date,
+	}
+}
+
+func (b *MembershipCategoryBuilder) WithName(name string) *MembershipCategoryBuilder {
+	b.name = name
+	return b
+}
+
+func (b *MembershipCategoryBuilder) WithMaxLoans(n int) *MembershipCategoryBuilder {
+	b.maxLoans = n
+	return b
+}
+
+func (b *MembershipCategoryBuilder) WithLoanPeriodDays(days int) *MembershipCategoryBuilder {
+	b.loanPeriodDays = days
+	return b
+}
+
+func (b *MembershipCategoryBuilder) WithRenewalLimit(n int) *MembershipCategoryBuilder {
+	b.renewalLimit = n
+	return b
+}
+
+func (b *MembershipCategoryBuilder) WithFine(dailyRateCents, graceDays, maxCents int) *MembershipCategoryBuilder {
+	b.fineDailyRateCents = dailyRateCents
+	b.fineGraceDays = graceDays
+	b.fineMaxCents = maxCents
+	return b
+}
+
+func (b *MembershipCategoryBuilder) Build() *model.MembershipCategory {
+	return &model.MembershipCategory{
+		Name:               b.name,
+		MaxLoans:           b.maxLoans,
+		LoanPeriodDays:     b.loanPeriodDays,
+		RenewalLimit:       b.renewalLimit,
+		FineDailyRateCents: b.fineDailyRateCents,
+		FineGraceDays:      b.fineGraceDays,
+		FineMaxCents:       b.fineMaxCents,
+		CreatedAt:          *b.createdAt,
+		UpdatedAt:          *b.createdAt,
+	}
+}
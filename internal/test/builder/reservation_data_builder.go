@@ -15,22 +15,64 @@ type ReservationBuilder struct {
 	reservedAt      *time.Time
 	canceledAt      *time.Time
 	fulfilledLoanID *uuid.UUID
+	heldCopyID      *uuid.UUID
+	readyAt         *time.Time
+	pickupDeadline  *time.Time
+	expiredAt       *time.Time
 	createdAt       *time.Time
 }
 
 func NewReservationBuilder() *ReservationBuilder {
 	now := time.Now()
 	return &ReservationBuilder{
-		id:              uuid.New(),
-		memberID:        uuid.New(),
-		bookID:          uuid.New(),
-		reservedAt:      &now,
-		canceledAt:      nil,
-		fulfilledLoanID: nil,
-		createdAt:       &now,
+		id:         uuid.New(),
+		memberID:   uuid.New(),
+		bookID:     uuid.New(),
+		reservedAt: &now,
+		createdAt:  &now,
 	}
 }
 
+func (b *ReservationBuilder) WithID(id uuid.UUID) *ReservationBuilder {
+	b.id = id
+	return b
+}
+
+func (b *ReservationBuilder) WithMemberID(id uuid.UUID) *ReservationBuilder {
+	b.memberID = id
+	return b
+}
+
+func (b *ReservationBuilder) WithBookID(id uuid.UUID) *ReservationBuilder {
+	b.bookID = id
+	return b
+}
+
+func (b *ReservationBuilder) WithCanceledAt(t time.Time) *ReservationBuilder {
+	b.canceledAt = &t
+	return b
+}
+
+func (b *ReservationBuilder) WithFulfilledLoanID(id uuid.UUID) *ReservationBuilder {
+	b.fulfilledLoanID = &id
+	return b
+}
+
+// WithHeldCopy puts the reservation on the hold shelf with copyID, to be
+// collected before deadline.
+func (b *ReservationBuilder) WithHeldCopy(copyID uuid.UUID, deadline time.Time) *ReservationBuilder {
+	readyAt := deadline.AddDate(0, 0, -3)
+	b.heldCopyID = &copyID
+	b.readyAt = &readyAt
+	b.pickupDeadline = &deadline
+	return b
+}
+
+func (b *ReservationBuilder) WithExpiredAt(t time.Time) *ReservationBuilder {
+	b.expiredAt = &t
+	return b
+}
+
 func (b *ReservationBuilder) Build() *model.Reservation {
 	return &model.Reservation{
 		ID:              b.id,
@@ -39,6 +81,10 @@ func (b *ReservationBuilder) Build() *model.Reservation {
 		ReservedAt:      *b.reservedAt,
 		CanceledAt:      b.canceledAt,
 		FulfilledLoanID: b.fulfilledLoanID,
+		HeldCopyID:      b.heldCopyID,
+		ReadyAt:         b.readyAt,
+		PickupDeadline:  b.pickupDeadline,
+		ExpiredAt:       b.expiredAt,
 		CreatedAt:       *b.createdAt,
 	}
 }
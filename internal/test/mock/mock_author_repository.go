@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"library/internal/domain/model"
+	"library/internal/domain/port"
 )
 
 type AuthorRepoMock struct {
@@ -18,9 +19,9 @@ func (m *AuthorRepoMock) Save(ctx context.Context, author *model.Author) error {
 	return args.Error(0)
 }
 
-func (m *AuthorRepoMock) FindAll(ctx context.Context) ([]model.Author, error) {
-	args := m.Called()
-	return args.Get(0).([]model.Author), args.Error(1)
+func (m *AuthorRepoMock) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Author], error) {
+	args := m.Called(query)
+	return args.Get(0).(*port.Page[model.Author]), args.Error(1)
 }
 
 func (m *AuthorRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.Author, error) {
@@ -32,3 +33,18 @@ func (m *AuthorRepoMock) Update(ctx context.Context, id uuid.UUID, patch *model.
 	args := m.Called(id, patch)
 	return args.Get(0).(*model.Author), args.Error(1)
 }
+
+func (m *AuthorRepoMock) Patch(ctx context.Context, id uuid.UUID, patch *model.AuthorPatch) (*model.Author, error) {
+	args := m.Called(id, patch)
+	return args.Get(0).(*model.Author), args.Error(1)
+}
+
+func (m *AuthorRepoMock) Delete(ctx context.Context, id uuid.UUID, force bool) error {
+	args := m.Called(id, force)
+	return args.Error(0)
+}
+
+func (m *AuthorRepoMock) Restore(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Author), args.Error(1)
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+)
+
+type BookAuthorRepoMock struct {
+	mock.Mock
+}
+
+func (m *BookAuthorRepoMock) Link(ctx context.Context, link *model.BookAuthor) error {
+	args := m.Called(link)
+	return args.Error(0)
+}
+
+func (m *BookAuthorRepoMock) Unlink(ctx context.Context, bookID, authorID uuid.UUID) error {
+	args := m.Called(bookID, authorID)
+	return args.Error(0)
+}
+
+func (m *BookAuthorRepoMock) FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookAuthor, error) {
+	args := m.Called(bookID)
+	return args.Get(0).([]model.BookAuthor), args.Error(1)
+}
+
+func (m *BookAuthorRepoMock) FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]model.BookAuthor, error) {
+	args := m.Called(authorID)
+	return args.Get(0).([]model.BookAuthor), args.Error(1)
+}
@@ -0,0 +1,54 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+)
+
+type BookCopyRepoMock struct {
+	mock.Mock
+}
+
+func (m *BookCopyRepoMock) Save(ctx context.Context, bookCopy *model.BookCopy) error {
+	args := m.Called(bookCopy)
+	return args.Error(0)
+}
+
+func (m *BookCopyRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.BookCopy), args.Error(1)
+}
+
+func (m *BookCopyRepoMock) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.BookCopy), args.Error(1)
+}
+
+func (m *BookCopyRepoMock) FindByBarcode(ctx context.Context, barcode string) (*model.BookCopy, error) {
+	args := m.Called(barcode)
+	return args.Get(0).(*model.BookCopy), args.Error(1)
+}
+
+func (m *BookCopyRepoMock) FindByBook(ctx context.Context, bookID uuid.UUID) ([]model.BookCopy, error) {
+	args := m.Called(bookID)
+	return args.Get(0).([]model.BookCopy), args.Error(1)
+}
+
+func (m *BookCopyRepoMock) SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.BookCopy, error) {
+	args := m.Called(id, active)
+	return args.Get(0).(*model.BookCopy), args.Error(1)
+}
+
+func (m *BookCopyRepoMock) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *BookCopyRepoMock) Restore(ctx context.Context, id uuid.UUID) (*model.BookCopy, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.BookCopy), args.Error(1)
+}
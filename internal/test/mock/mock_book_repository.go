@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type BookRepoMock struct {
+	mock.Mock
+}
+
+func (m *BookRepoMock) Save(ctx context.Context, book *model.Book) error {
+	args := m.Called(book)
+	return args.Error(0)
+}
+
+func (m *BookRepoMock) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Book], error) {
+	args := m.Called(query)
+	return args.Get(0).(*port.Page[model.Book]), args.Error(1)
+}
+
+func (m *BookRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Book), args.Error(1)
+}
+
+func (m *BookRepoMock) Update(ctx context.Context, id uuid.UUID, patch *model.Book) (*model.Book, error) {
+	args := m.Called(id, patch)
+	return args.Get(0).(*model.Book), args.Error(1)
+}
+
+func (m *BookRepoMock) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *BookRepoMock) Restore(ctx context.Context, id uuid.UUID) (*model.Book, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Book), args.Error(1)
+}
@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+)
+
+type FineTransactionRepoMock struct {
+	mock.Mock
+}
+
+func (m *FineTransactionRepoMock) Save(ctx context.Context, transaction *model.FineTransaction) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
+func (m *FineTransactionRepoMock) FindByLoan(ctx context.Context, loanID uuid.UUID) ([]model.FineTransaction, error) {
+	args := m.Called(loanID)
+	return args.Get(0).([]model.FineTransaction), args.Error(1)
+}
+
+func (m *FineTransactionRepoMock) FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.FineTransaction, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]model.FineTransaction), args.Error(1)
+}
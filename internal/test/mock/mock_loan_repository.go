@@ -0,0 +1,65 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+)
+
+type LoanRepoMock struct {
+	mock.Mock
+}
+
+func (m *LoanRepoMock) Save(ctx context.Context, loan *model.Loan) error {
+	args := m.Called(loan)
+	return args.Error(0)
+}
+
+func (m *LoanRepoMock) FindActiveByCopy(ctx context.Context, copyID uuid.UUID) (*model.Loan, error) {
+	args := m.Called(copyID)
+	return args.Get(0).(*model.Loan), args.Error(1)
+}
+
+func (m *LoanRepoMock) Close(ctx context.Context, loan *model.Loan) error {
+	args := m.Called(loan)
+	return args.Error(0)
+}
+
+func (m *LoanRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.Loan, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Loan), args.Error(1)
+}
+
+func (m *LoanRepoMock) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Loan, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Loan), args.Error(1)
+}
+
+func (m *LoanRepoMock) FindByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]model.Loan), args.Error(1)
+}
+
+func (m *LoanRepoMock) FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Loan, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]model.Loan), args.Error(1)
+}
+
+func (m *LoanRepoMock) CountActiveByMember(ctx context.Context, memberID uuid.UUID) (int, error) {
+	args := m.Called(memberID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *LoanRepoMock) CountOverdue(ctx context.Context, dueBefore time.Time) (int, error) {
+	args := m.Called(dueBefore)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *LoanRepoMock) Renew(ctx context.Context, loan *model.Loan) error {
+	args := m.Called(loan)
+	return args.Error(0)
+}
@@ -0,0 +1,60 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type MemberRepoMock struct {
+	mock.Mock
+}
+
+func (m *MemberRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MemberRepoMock) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MemberRepoMock) Save(ctx context.Context, member *model.Member) error {
+	args := m.Called(member)
+	return args.Error(0)
+}
+
+func (m *MemberRepoMock) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.Member], error) {
+	args := m.Called(query)
+	return args.Get(0).(*port.Page[model.Member]), args.Error(1)
+}
+
+func (m *MemberRepoMock) FindByEmail(ctx context.Context, email string) (*model.Member, error) {
+	args := m.Called(email)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MemberRepoMock) Update(ctx context.Context, id uuid.UUID, patch *model.Member) (*model.Member, error) {
+	args := m.Called(id, patch)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MemberRepoMock) SetActive(ctx context.Context, id uuid.UUID, active bool) (*model.Member, error) {
+	args := m.Called(id, active)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MemberRepoMock) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MemberRepoMock) Restore(ctx context.Context, id uuid.UUID) (*model.Member, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
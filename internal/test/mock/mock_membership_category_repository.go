@@ -0,0 +1,38 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type MembershipCategoryRepoMock struct {
+	mock.Mock
+}
+
+func (m *MembershipCategoryRepoMock) Save(ctx context.Context, category *model.MembershipCategory) error {
+	args := m.Called(category)
+	return args.Error(0)
+}
+
+func (m *MembershipCategoryRepoMock) FindAll(ctx context.Context, query port.ListQuery) (*port.Page[model.MembershipCategory], error) {
+	args := m.Called(query)
+	return args.Get(0).(*port.Page[model.MembershipCategory]), args.Error(1)
+}
+
+func (m *MembershipCategoryRepoMock) FindByName(ctx context.Context, name string) (*model.MembershipCategory, error) {
+	args := m.Called(name)
+	return args.Get(0).(*model.MembershipCategory), args.Error(1)
+}
+
+func (m *MembershipCategoryRepoMock) Update(
+	ctx context.Context,
+	name string,
+	category *model.MembershipCategory,
+) (*model.MembershipCategory, error) {
+	args := m.Called(name, category)
+	return args.Get(0).(*model.MembershipCategory), args.Error(1)
+}
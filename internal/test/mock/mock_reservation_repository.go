@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+)
+
+type ReservationRepoMock struct {
+	mock.Mock
+}
+
+func (m *ReservationRepoMock) Save(ctx context.Context, reservation *model.Reservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
+
+func (m *ReservationRepoMock) FindById(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	args := m.Called(id)
+	return args.Get(0).(*model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) FindActiveByBook(ctx context.Context, bookID uuid.UUID) ([]model.Reservation, error) {
+	args := m.Called(bookID)
+	return args.Get(0).([]model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) FindActiveByMember(ctx context.Context, memberID uuid.UUID) ([]model.Reservation, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) Position(ctx context.Context, reservation *model.Reservation) (int, error) {
+	args := m.Called(reservation)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *ReservationRepoMock) ExistsActiveForBook(ctx context.Context, bookID, excludeMemberID uuid.UUID) (bool, error) {
+	args := m.Called(bookID, excludeMemberID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *ReservationRepoMock) FindNextForBook(ctx context.Context, bookID uuid.UUID) (*model.Reservation, error) {
+	args := m.Called(bookID)
+	return args.Get(0).(*model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) Cancel(ctx context.Context, reservation *model.Reservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
+
+func (m *ReservationRepoMock) Fulfil(ctx context.Context, reservation *model.Reservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
+
+func (m *ReservationRepoMock) FindReadyByCopy(ctx context.Context, copyID uuid.UUID) (*model.Reservation, error) {
+	args := m.Called(copyID)
+	return args.Get(0).(*model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) FindHoldShelf(ctx context.Context) ([]model.Reservation, error) {
+	args := m.Called()
+	return args.Get(0).([]model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) FindExpiredHolds(ctx context.Context, asOf time.Time, limit int) ([]model.Reservation, error) {
+	args := m.Called(asOf, limit)
+	return args.Get(0).([]model.Reservation), args.Error(1)
+}
+
+func (m *ReservationRepoMock) MarkReady(ctx context.Context, reservation *model.Reservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
+
+func (m *ReservationRepoMock) Expire(ctx context.Context, reservation *model.Reservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"library/internal/domain/model"
+	"library/internal/domain/port"
+)
+
+type SearchRepoMock struct {
+	mock.Mock
+}
+
+func (m *SearchRepoMock) SearchBooks(ctx context.Context, query port.SearchQuery) (*model.SearchResult, error) {
+	args := m.Called(query)
+	return args.Get(0).(*model.SearchResult), args.Error(1)
+}
@@ -0,0 +1,10 @@
+package mock
+
+import "context"
+
+// TransactionMock runs the callback inline, without a real transaction.
+type TransactionMock struct{}
+
+func (TransactionMock) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
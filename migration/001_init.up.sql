@@ -171,27 +171,3 @@ ALTER TABLE loans
 
 ALTER TABLE loans
   ADD CONSTRAINT chk_fine_non_negative CHECK (fine_cents >= 0);
-  
--- limpiar la dba
-
--- Rollback del esquema inicial
-/*
-	SET search_path TO library, public;
-
-	DROP INDEX IF EXISTS uniq_active_reservation_per_member_book;
-	DROP INDEX IF EXISTS uniq_active_loan_per_copy;
-	
-	DROP TABLE IF EXISTS reservations;
-	DROP TABLE IF EXISTS loans;
-	DROP TABLE IF EXISTS book_copies;
-	DROP TABLE IF EXISTS book_authors;
-	DROP TABLE IF EXISTS members;
-	DROP TABLE IF EXISTS books;
-	DROP TABLE IF EXISTS authors;
-
--- Función utilitaria
-	DROP FUNCTION IF EXISTS set_updated_at();
-
--- (Opcional) eliminar el esquema
--- DROP SCHEMA IF EXISTS library CASCADE;
-*/
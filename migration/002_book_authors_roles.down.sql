@@ -0,0 +1,9 @@
+-- =============================================================================
+-- library_go - deshace: rol y orden de autores por libro
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_book_authors_author_id;
+ALTER TABLE book_authors DROP CONSTRAINT IF EXISTS chk_book_authors_position;
+ALTER TABLE book_authors DROP CONSTRAINT IF EXISTS chk_book_authors_role;
+ALTER TABLE book_authors DROP COLUMN IF EXISTS position, DROP COLUMN IF EXISTS role;
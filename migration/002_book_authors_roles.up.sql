@@ -0,0 +1,17 @@
+-- =============================================================================
+-- library_go - rol y orden de autores por libro
+-- =============================================================================
+SET search_path TO library, public;
+
+ALTER TABLE book_authors
+  ADD COLUMN IF NOT EXISTS role     TEXT NOT NULL DEFAULT 'author',
+  ADD COLUMN IF NOT EXISTS position INT  NOT NULL DEFAULT 1;
+
+ALTER TABLE book_authors
+  ADD CONSTRAINT chk_book_authors_role CHECK (role IN ('author', 'editor', 'translator', 'illustrator'));
+
+ALTER TABLE book_authors
+  ADD CONSTRAINT chk_book_authors_position CHECK (position > 0);
+
+-- Consulta inversa: libros de un autor
+CREATE INDEX IF NOT EXISTS idx_book_authors_author_id ON book_authors (author_id);
@@ -0,0 +1,7 @@
+-- =============================================================================
+-- library_go - deshace: multas por atraso: categoría de socio, pagos y condonaciones
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP TABLE IF EXISTS fine_transactions;
+ALTER TABLE members DROP COLUMN IF EXISTS category;
@@ -0,0 +1,28 @@
+-- =============================================================================
+-- library_go - multas por atraso: categoría de socio, pagos y condonaciones
+-- =============================================================================
+SET search_path TO library, public;
+
+-- Categoría del socio: selecciona la regla de multa (ver FINE_CATEGORY_RULES)
+ALTER TABLE members
+  ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT 'standard';
+
+-- =============================================================================
+-- Tabla: fine_transactions (pagos y condonaciones de multas)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS fine_transactions (
+    id            UUID PRIMARY KEY,
+    loan_id       UUID NOT NULL,
+    member_id     UUID NOT NULL,
+    kind          TEXT NOT NULL,            -- payment | waiver
+    amount_cents  INT  NOT NULL,
+    note          TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_fine_transactions_loan FOREIGN KEY (loan_id) REFERENCES loans(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_fine_transactions_member FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_fine_transactions_kind CHECK (kind IN ('payment', 'waiver')),
+    CONSTRAINT chk_fine_transactions_amount CHECK (amount_cents > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fine_transactions_loan_id ON fine_transactions (loan_id);
+CREATE INDEX IF NOT EXISTS idx_fine_transactions_member_id ON fine_transactions (member_id);
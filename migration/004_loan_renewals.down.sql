@@ -0,0 +1,8 @@
+-- =============================================================================
+-- library_go - deshace: renovaciones de préstamos
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_reservations_active_book;
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_renewal_count_non_negative;
+ALTER TABLE loans DROP COLUMN IF EXISTS renewal_count;
@@ -0,0 +1,15 @@
+-- =============================================================================
+-- library_go - renovaciones de préstamos
+-- =============================================================================
+SET search_path TO library, public;
+
+ALTER TABLE loans
+  ADD COLUMN IF NOT EXISTS renewal_count INT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+  ADD CONSTRAINT chk_renewal_count_non_negative CHECK (renewal_count >= 0);
+
+-- Reservas activas por libro (bloquean renovaciones de otros socios)
+CREATE INDEX IF NOT EXISTS idx_reservations_active_book
+  ON reservations (book_id)
+  WHERE canceled_at IS NULL AND fulfilled_loan_id IS NULL;
@@ -0,0 +1,21 @@
+-- =============================================================================
+-- library_go - deshace: estantería de reservas (hold shelf) y expiración
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_reservations_pickup_deadline;
+DROP INDEX IF EXISTS idx_reservations_held_copy;
+DROP INDEX IF EXISTS idx_reservations_active_book;
+CREATE INDEX idx_reservations_active_book
+  ON reservations (book_id)
+  WHERE canceled_at IS NULL AND fulfilled_loan_id IS NULL;
+DROP INDEX IF EXISTS uniq_active_reservation_per_member_book;
+CREATE UNIQUE INDEX uniq_active_reservation_per_member_book
+  ON reservations (member_id, book_id)
+  WHERE canceled_at IS NULL AND fulfilled_loan_id IS NULL;
+ALTER TABLE reservations DROP CONSTRAINT IF EXISTS fk_reservations_held_copy;
+ALTER TABLE reservations
+  DROP COLUMN IF EXISTS expired_at,
+  DROP COLUMN IF EXISTS pickup_deadline,
+  DROP COLUMN IF EXISTS ready_at,
+  DROP COLUMN IF EXISTS held_copy_id;
@@ -0,0 +1,33 @@
+-- =============================================================================
+-- library_go - estantería de reservas (hold shelf) y expiración
+-- =============================================================================
+SET search_path TO library, public;
+
+ALTER TABLE reservations
+  ADD COLUMN IF NOT EXISTS held_copy_id    UUID,
+  ADD COLUMN IF NOT EXISTS ready_at        TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS pickup_deadline TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS expired_at      TIMESTAMPTZ;
+
+ALTER TABLE reservations
+  ADD CONSTRAINT fk_reservations_held_copy FOREIGN KEY (held_copy_id) REFERENCES book_copies(id) ON DELETE SET NULL;
+
+-- Una reserva expirada deja de estar activa
+DROP INDEX IF EXISTS uniq_active_reservation_per_member_book;
+CREATE UNIQUE INDEX uniq_active_reservation_per_member_book
+  ON reservations (member_id, book_id)
+  WHERE canceled_at IS NULL AND fulfilled_loan_id IS NULL AND expired_at IS NULL;
+
+DROP INDEX IF EXISTS idx_reservations_active_book;
+CREATE INDEX IF NOT EXISTS idx_reservations_active_book
+  ON reservations (book_id)
+  WHERE canceled_at IS NULL AND fulfilled_loan_id IS NULL AND expired_at IS NULL;
+
+-- Copias apartadas: disponibilidad por copia y barrido de plazos vencidos
+CREATE INDEX IF NOT EXISTS idx_reservations_held_copy
+  ON reservations (held_copy_id)
+  WHERE held_copy_id IS NOT NULL AND canceled_at IS NULL AND fulfilled_loan_id IS NULL AND expired_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reservations_pickup_deadline
+  ON reservations (pickup_deadline)
+  WHERE held_copy_id IS NOT NULL AND canceled_at IS NULL AND fulfilled_loan_id IS NULL AND expired_at IS NULL;
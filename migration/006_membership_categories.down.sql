@@ -0,0 +1,8 @@
+-- =============================================================================
+-- library_go - deshace: categorías de socio (límites de préstamo, renovación y multas)
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_loans_active_member;
+ALTER TABLE members DROP CONSTRAINT IF EXISTS fk_members_membership_category;
+DROP TABLE IF EXISTS membership_categories;
@@ -0,0 +1,45 @@
+-- =============================================================================
+-- library_go - categorías de socio (límites de préstamo, renovación y multas)
+-- =============================================================================
+SET search_path TO library, public;
+
+CREATE TABLE IF NOT EXISTS membership_categories (
+    name                  TEXT PRIMARY KEY,
+    max_loans             INT  NOT NULL,
+    loan_period_days      INT  NOT NULL,
+    renewal_limit         INT  NOT NULL,
+    fine_daily_rate_cents INT  NOT NULL,
+    fine_grace_days       INT  NOT NULL DEFAULT 0,
+    fine_max_cents        INT  NOT NULL DEFAULT 0,   -- 0 = sin tope
+    created_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_membership_categories_max_loans CHECK (max_loans > 0),
+    CONSTRAINT chk_membership_categories_loan_period CHECK (loan_period_days > 0),
+    CONSTRAINT chk_membership_categories_renewal_limit CHECK (renewal_limit >= 0),
+    CONSTRAINT chk_membership_categories_fine CHECK (
+        fine_daily_rate_cents >= 0 AND fine_grace_days >= 0 AND fine_max_cents >= 0
+    )
+);
+
+-- Categorías iniciales
+INSERT INTO membership_categories
+    (name, max_loans, loan_period_days, renewal_limit, fine_daily_rate_cents, fine_grace_days, fine_max_cents)
+VALUES
+    ('standard', 5, 14, 2, 25, 0, 0),
+    ('student', 3, 21, 1, 10, 2, 500),
+    ('staff', 10, 28, 3, 0, 0, 0),
+    ('public', 2, 14, 1, 25, 0, 0)
+ON CONFLICT (name) DO NOTHING;
+
+-- Socios con categorías desconocidas pasan a la categoría por defecto
+UPDATE members SET category = 'standard'
+WHERE category NOT IN (SELECT name FROM membership_categories);
+
+ALTER TABLE members
+  ADD CONSTRAINT fk_members_membership_category FOREIGN KEY (category)
+    REFERENCES membership_categories(name) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+-- Conteo de préstamos abiertos por socio
+CREATE INDEX IF NOT EXISTS idx_loans_active_member
+  ON loans (member_id)
+  WHERE returned_at IS NULL;
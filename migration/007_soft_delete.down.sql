@@ -0,0 +1,23 @@
+-- =============================================================================
+-- library_go - deshace: borrado lógico de autores, libros, copias y socios
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS uniq_live_member_email;
+DROP INDEX IF EXISTS uniq_live_copy_barcode;
+DROP INDEX IF EXISTS uniq_live_book_isbn;
+
+-- Falla si quedan duplicados entre registros borrados y vivos
+ALTER TABLE members     ADD CONSTRAINT members_email_key UNIQUE (email);
+ALTER TABLE book_copies ADD CONSTRAINT book_copies_barcode_key UNIQUE (barcode);
+ALTER TABLE books       ADD CONSTRAINT books_isbn_key UNIQUE (isbn);
+
+DROP INDEX IF EXISTS idx_members_deleted_at;
+DROP INDEX IF EXISTS idx_book_copies_deleted_at;
+DROP INDEX IF EXISTS idx_books_deleted_at;
+DROP INDEX IF EXISTS idx_authors_deleted_at;
+
+ALTER TABLE members     DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE book_copies DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE books       DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE authors     DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,35 @@
+-- =============================================================================
+-- library_go - borrado lógico de autores, libros, copias y socios
+-- =============================================================================
+SET search_path TO library, public;
+
+ALTER TABLE authors     ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE books       ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE book_copies ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE members     ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_authors_deleted_at     ON authors (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_books_deleted_at       ON books (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_book_copies_deleted_at ON book_copies (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_members_deleted_at     ON members (deleted_at);
+
+-- ISBN, código de barras y email solo son únicos entre los registros vivos:
+-- un registro borrado no bloquea el alta de otro con el mismo valor
+ALTER TABLE books       DROP CONSTRAINT IF EXISTS books_isbn_key;
+ALTER TABLE book_copies DROP CONSTRAINT IF EXISTS book_copies_barcode_key;
+ALTER TABLE members     DROP CONSTRAINT IF EXISTS members_email_key;
+DROP INDEX IF EXISTS idx_books_isbn;
+DROP INDEX IF EXISTS idx_book_copies_barcode;
+DROP INDEX IF EXISTS idx_members_email;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_live_book_isbn
+  ON books (isbn)
+  WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_live_copy_barcode
+  ON book_copies (barcode)
+  WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_live_member_email
+  ON members (email)
+  WHERE deleted_at IS NULL;
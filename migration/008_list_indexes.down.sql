@@ -0,0 +1,10 @@
+-- =============================================================================
+-- library_go - deshace: índices para paginar listados por keyset (columna de orden, id)
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_members_full_name_id;
+DROP INDEX IF EXISTS idx_books_title_id;
+DROP INDEX IF EXISTS idx_authors_last_name_id;
+DROP INDEX IF EXISTS idx_books_created_at_id;
+DROP INDEX IF EXISTS idx_authors_created_at_id;
@@ -0,0 +1,15 @@
+-- =============================================================================
+-- library_go - índices para paginar listados por keyset (columna de orden, id)
+-- =============================================================================
+SET search_path TO library, public;
+
+-- Orden por defecto de autores y libros
+CREATE INDEX IF NOT EXISTS idx_authors_created_at_id ON authors (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_books_created_at_id   ON books (created_at, id);
+
+-- Ordenaciones alternativas permitidas por la API
+CREATE INDEX IF NOT EXISTS idx_authors_last_name_id  ON authors (last_name, id);
+CREATE INDEX IF NOT EXISTS idx_books_title_id        ON books (title, id);
+
+-- Orden por defecto de socios
+CREATE INDEX IF NOT EXISTS idx_members_full_name_id  ON members (full_name, id);
@@ -0,0 +1,8 @@
+-- =============================================================================
+-- library_go - deshace: índices de búsqueda de texto completo del catálogo
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_books_isbn_bare;
+DROP INDEX IF EXISTS idx_authors_name_fts;
+DROP INDEX IF EXISTS idx_books_description_fts;
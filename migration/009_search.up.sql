@@ -0,0 +1,14 @@
+-- =============================================================================
+-- library_go - índices de búsqueda de texto completo del catálogo
+-- =============================================================================
+SET search_path TO library, public;
+
+-- idx_books_title (001_init.sql) ya cubre los títulos. Las expresiones deben
+-- coincidir con las de la consulta de búsqueda para que se usen los índices.
+CREATE INDEX IF NOT EXISTS idx_books_description_fts ON books
+    USING GIN (to_tsvector('simple', coalesce(description, '')));
+CREATE INDEX IF NOT EXISTS idx_authors_name_fts ON authors
+    USING GIN (to_tsvector('simple', first_name || ' ' || last_name));
+
+-- Búsqueda por ISBN sin guiones
+CREATE INDEX IF NOT EXISTS idx_books_isbn_bare ON books (replace(isbn, '-', ''));
@@ -0,0 +1,23 @@
+-- =============================================================================
+-- library_go - deshace: alinea el esquema con los modelos (antes lo hacía AutoMigrate)
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP TRIGGER IF EXISTS trg_membership_categories_updated_at ON membership_categories;
+DROP TRIGGER IF EXISTS trg_authors_updated_at ON authors;
+
+-- Se pierde la hora del vencimiento
+ALTER TABLE loans ALTER COLUMN due_date TYPE DATE;
+
+ALTER TABLE authors
+  ALTER COLUMN first_name TYPE TEXT,
+  ALTER COLUMN last_name  TYPE TEXT,
+  ALTER COLUMN bio        TYPE TEXT;
+
+ALTER TABLE fine_transactions ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE reservations      ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE loans             ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE book_copies       ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE members           ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE books             ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE authors           ALTER COLUMN id DROP DEFAULT;
@@ -0,0 +1,31 @@
+-- =============================================================================
+-- library_go - alinea el esquema con los modelos (antes lo hacía AutoMigrate)
+-- =============================================================================
+SET search_path TO library, public;
+
+-- La base de datos genera el id cuando la aplicación no lo envía
+ALTER TABLE authors           ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE books             ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE members           ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE book_copies       ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE loans             ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE reservations      ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE fine_transactions ALTER COLUMN id SET DEFAULT gen_random_uuid();
+
+-- Mismos límites que valida la API para autores
+ALTER TABLE authors
+  ALTER COLUMN first_name TYPE VARCHAR(200),
+  ALTER COLUMN last_name  TYPE VARCHAR(200),
+  ALTER COLUMN bio        TYPE VARCHAR(500);
+
+-- El vencimiento de un préstamo es un instante, no solo una fecha
+ALTER TABLE loans ALTER COLUMN due_date TYPE TIMESTAMPTZ;
+
+-- updated_at también se mantiene en autores y categorías de socio
+CREATE TRIGGER trg_authors_updated_at
+BEFORE UPDATE ON authors
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TRIGGER trg_membership_categories_updated_at
+BEFORE UPDATE ON membership_categories
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,7 @@
+-- =============================================================================
+-- library_go - deshace: búsqueda por ISBN sin guiones ni espacios
+-- =============================================================================
+SET search_path TO library, public;
+
+DROP INDEX IF EXISTS idx_books_isbn_bare;
+CREATE INDEX IF NOT EXISTS idx_books_isbn_bare ON books (replace(isbn, '-', ''));
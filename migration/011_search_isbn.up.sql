@@ -0,0 +1,9 @@
+-- =============================================================================
+-- library_go - búsqueda por ISBN sin guiones ni espacios
+-- =============================================================================
+SET search_path TO library, public;
+
+-- La API acepta ISBN con espacios y con la x en minúscula; el índice debe
+-- coincidir con la normalización de la consulta de búsqueda
+DROP INDEX IF EXISTS idx_books_isbn_bare;
+CREATE INDEX IF NOT EXISTS idx_books_isbn_bare ON books (upper(translate(isbn, '- ', '')));
@@ -0,0 +1,9 @@
+-- =============================================================================
+-- library_go - deshace: año de publicación obligatorio (0 = desconocido)
+-- =============================================================================
+SET search_path TO library, public;
+
+-- Los años desconocidos siguen en 0
+ALTER TABLE books
+  ALTER COLUMN published_year DROP NOT NULL,
+  ALTER COLUMN published_year DROP DEFAULT;
@@ -0,0 +1,12 @@
+-- =============================================================================
+-- library_go - año de publicación obligatorio (0 = desconocido)
+-- =============================================================================
+SET search_path TO library, public;
+
+-- Un NULL rompe la paginación por keyset ordenada por año: la comparación
+-- (published_year, id) > (?, ?) da NULL. La API ya usa 0 para "sin año".
+UPDATE books SET published_year = 0 WHERE published_year IS NULL;
+
+ALTER TABLE books
+  ALTER COLUMN published_year SET DEFAULT 0,
+  ALTER COLUMN published_year SET NOT NULL;
@@ -0,0 +1,9 @@
+// Package migration holds the versioned SQL migrations of the library schema.
+// Each version is a pair of files, NNN_name.up.sql and NNN_name.down.sql,
+// applied in version order by cmd/migrate.
+package migration
+
+import "embed"
+
+//go:embed *.sql
+var Files embed.FS